@@ -0,0 +1,12 @@
+action "globalscapeeft_ha_upgrade_state" "begin" {
+  config {
+    desired_state = "upgrade"
+  }
+}
+
+action "globalscapeeft_ha_upgrade_state" "end" {
+  config {
+    desired_state = "normal"
+    timeout       = "30m"
+  }
+}
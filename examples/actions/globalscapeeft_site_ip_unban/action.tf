@@ -0,0 +1,15 @@
+variable "ban_id" {
+  description = "Banned address to release, as listed by globalscapeeft_site_ip_auto_bans."
+  type        = string
+}
+
+data "globalscapeeft_site" "main" {
+  name = "MySite"
+}
+
+action "globalscapeeft_site_ip_unban" "partner" {
+  config {
+    site_id = data.globalscapeeft_site.main.id
+    ban_id  = var.ban_id
+  }
+}
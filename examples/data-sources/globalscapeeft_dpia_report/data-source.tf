@@ -0,0 +1,9 @@
+data "globalscapeeft_dpia_report" "current" {}
+
+output "globalscapeeft_dpia_risks" {
+  value = flatten([
+    for s in data.globalscapeeft_dpia_report.current.sections : [
+      for a in s.articles : "${a.number}: ${a.name} = ${a.value}" if a.score > 0
+    ]
+  ])
+}
@@ -0,0 +1,5 @@
+data "globalscapeeft_node_licenses" "primary" {}
+
+output "globalscapeeft_unregistered_modules" {
+  value = [for l in data.globalscapeeft_node_licenses.primary.licenses : l.module if !l.registered]
+}
@@ -0,0 +1,7 @@
+data "globalscapeeft_node_metrics" "primary" {
+  node_id = "WIN-4NFJASSP163"
+}
+
+output "globalscapeeft_node_connected_users" {
+  value = data.globalscapeeft_node_metrics.primary.activity.connected_user_count
+}
@@ -0,0 +1,5 @@
+data "globalscapeeft_nodes" "all" {}
+
+output "globalscapeeft_master_node" {
+  value = [for n in data.globalscapeeft_nodes.all.nodes : n.id if n.role != "member"]
+}
@@ -0,0 +1,13 @@
+data "globalscapeeft_pci_compliance_report" "current" {
+  lifecycle {
+    postcondition {
+      condition     = alltrue([for s in self.sites : s.failed == 0 if s.site_name == "PCI_SITE"])
+      error_message = "PCI_SITE has failing PCI DSS checks."
+    }
+  }
+}
+
+resource "local_file" "pci_report" {
+  filename = "${path.module}/reports/pci.json"
+  content  = data.globalscapeeft_pci_compliance_report.current.raw_json
+}
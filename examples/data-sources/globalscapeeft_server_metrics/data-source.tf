@@ -0,0 +1,8 @@
+data "globalscapeeft_server_metrics" "current" {
+  lifecycle {
+    postcondition {
+      condition     = self.active_transfer_count == 0
+      error_message = "Refusing to apply while transfers are in progress."
+    }
+  }
+}
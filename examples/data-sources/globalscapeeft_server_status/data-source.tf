@@ -0,0 +1,12 @@
+data "globalscapeeft_server_status" "current" {
+  lifecycle {
+    postcondition {
+      condition     = self.all_nodes_started
+      error_message = "Every EFT node must be started before applying changes."
+    }
+  }
+}
+
+output "globalscapeeft_active_transfers" {
+  value = data.globalscapeeft_server_status.current.active_transfer_count
+}
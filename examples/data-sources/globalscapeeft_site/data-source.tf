@@ -0,0 +1,11 @@
+data "globalscapeeft_site" "main" {
+  name = "MySite"
+}
+
+output "site_id" {
+  value = data.globalscapeeft_site.main.id
+}
+
+output "site_https_port" {
+  value = data.globalscapeeft_site.main.https_port
+}
@@ -0,0 +1,11 @@
+data "globalscapeeft_site" "main" {
+  name = "MySite"
+}
+
+data "globalscapeeft_site_ip_auto_bans" "main" {
+  site_id = data.globalscapeeft_site.main.id
+}
+
+output "banned_addresses" {
+  value = data.globalscapeeft_site_ip_auto_bans.main.bans[*].id
+}
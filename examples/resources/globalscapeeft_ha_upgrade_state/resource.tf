@@ -0,0 +1,13 @@
+variable "maintenance_window" {
+  type    = bool
+  default = false
+}
+
+resource "globalscapeeft_ha_upgrade_state" "cluster" {
+  desired_state = var.maintenance_window ? "upgrade" : "normal"
+
+  timeouts {
+    create = "30m"
+    update = "30m"
+  }
+}
@@ -0,0 +1,14 @@
+variable "eft_serial_numbers" {
+  type      = map(string)
+  sensitive = true
+}
+
+data "globalscapeeft_nodes" "all" {}
+
+resource "globalscapeeft_node_license" "eft_server" {
+  for_each = { for n in data.globalscapeeft_nodes.all.nodes : n.id => n }
+
+  node_id       = each.key
+  module        = "EFTServer"
+  serial_number = var.eft_serial_numbers[each.key]
+}
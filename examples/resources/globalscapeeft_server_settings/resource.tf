@@ -0,0 +1,6 @@
+resource "globalscapeeft_server_settings" "default" {
+  listen_ips                   = ["0.0.0.0"]
+  admin_port                   = 1100
+  enable_remote_administration = true
+  enable_utc_in_listings       = true
+}
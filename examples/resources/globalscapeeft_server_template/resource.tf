@@ -0,0 +1,4 @@
+resource "globalscapeeft_server_template" "password_reset" {
+  template_name = "PasswordResetReminderMsg.html"
+  source        = "${path.module}/templates/PasswordResetReminderMsg.html"
+}
@@ -0,0 +1,18 @@
+variable "acme_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "globalscapeeft_site" "partners" {
+  name                         = "Partners"
+  root_folder                  = "C:\\InetPub\\EFTRoot\\Partners\\"
+  pci_compliance_enabled       = true
+  create_unix_style_subfolders = true
+  create_folders_for_new_users = true
+}
+
+resource "globalscapeeft_site_user" "acme" {
+  site_id    = globalscapeeft_site.partners.id
+  login_name = "acme"
+  password   = var.acme_password
+}
@@ -0,0 +1,24 @@
+data "globalscapeeft_sites" "all" {}
+
+# Apply the corporate lockout standard to every site.
+resource "globalscapeeft_site_account_security" "standard" {
+  for_each = { for s in data.globalscapeeft_sites.all.sites : s.name => s.id }
+
+  site_id = each.value
+
+  invalid_login = {
+    account = {
+      enabled                = true
+      action                 = "Lockout"
+      attempt_period_minutes = 15
+      attempt_retries        = 5
+      lockout_minutes        = 30
+    }
+  }
+
+  inactivity = {
+    enabled           = true
+    action            = "Disable"
+    max_inactive_days = 90
+  }
+}
@@ -0,0 +1,23 @@
+variable "ldap_bind_password" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "globalscapeeft_site_authentication" "partners" {
+  site_id = globalscapeeft_site.partners.id
+  type    = "LDAP"
+
+  ldap {
+    server                = "ldap.example.com"
+    port                  = 636
+    use_ssl               = true
+    base_dn               = "ou=partners,dc=example,dc=com"
+    bind_username         = "cn=eft,ou=service,dc=example,dc=com"
+    bind_password         = var.ldap_bind_password
+    bind_password_version = 1
+    ldap_version          = 3
+    search_scope          = 2
+    timeout_seconds       = 60
+  }
+}
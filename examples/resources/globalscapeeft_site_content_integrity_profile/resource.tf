@@ -0,0 +1,20 @@
+data "globalscapeeft_site" "main" {
+  name = "MySite"
+}
+
+# Fail closed: uploads are rejected when the scanner is unreachable.
+resource "globalscapeeft_site_content_integrity_profile" "antivirus" {
+  site_id = data.globalscapeeft_site.main.id
+  name    = "ICAP antivirus"
+  host    = "icap.example.com"
+  path    = "/avscan"
+
+  on_connectivity_error = "Fail"
+  on_http_error         = "Fail"
+  on_icap_violation     = "Fail"
+}
+
+output "antivirus_profile_id" {
+  description = "GUID to reference from event rule actions."
+  value       = globalscapeeft_site_content_integrity_profile.antivirus.profile_id
+}
@@ -0,0 +1,21 @@
+data "globalscapeeft_site" "main" {
+  name = "MySite"
+}
+
+variable "report_group_id" {
+  description = "Permission group allowed to run SITE REPORT."
+  type        = string
+}
+
+resource "globalscapeeft_site_custom_command" "report" {
+  site_id         = data.globalscapeeft_site.main.id
+  name            = "REPORT"
+  executable      = "c:\\windows\\system32\\cscript.exe"
+  parameters      = "\"c:\\scripts\\report.vbs\" %1"
+  timeout_seconds = 300
+
+  min_parameters         = 1
+  min_parameters_message = "Usage: SITE REPORT <date>"
+
+  permission_group_ids = [var.report_group_id]
+}
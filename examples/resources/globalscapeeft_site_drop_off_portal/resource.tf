@@ -0,0 +1,33 @@
+variable "recaptcha_site_key" {
+  type = string
+}
+
+variable "recaptcha_secret_key" {
+  type      = string
+  sensitive = true
+}
+
+data "globalscapeeft_site" "main" {
+  name = "MySite"
+}
+
+resource "globalscapeeft_site_drop_off_portal" "main" {
+  site_id = data.globalscapeeft_site.main.id
+
+  enabled            = true
+  captcha_type       = "reCAPTCHA"
+  captcha_site_key   = var.recaptcha_site_key
+  captcha_secret_key = var.recaptcha_secret_key
+
+  link_expiration_value    = 1
+  link_expiration_units    = "Week"
+  max_message_size_enabled = true
+  max_message_size_mb      = 2048
+
+  # Anonymous senders may only pick from the address book.
+  allow_recipient_address = false
+  address_book = [
+    "Support<support@example.com>",
+    "Compliance<compliance@example.com>",
+  ]
+}
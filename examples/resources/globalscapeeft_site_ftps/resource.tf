@@ -0,0 +1,12 @@
+data "globalscapeeft_sites" "all" {}
+
+# Disable plain FTP on every site while keeping FTPS available.
+resource "globalscapeeft_site_ftps" "no_plain_ftp" {
+  for_each = { for s in data.globalscapeeft_sites.all.sites : s.name => s.id }
+
+  site_id               = each.value
+  ftp_enabled           = false
+  explicit_ftps_enabled = true
+  implicit_ftps_enabled = true
+  implicit_ftps_port    = 990
+}
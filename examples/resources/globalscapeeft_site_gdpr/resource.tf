@@ -0,0 +1,14 @@
+data "globalscapeeft_site" "main" {
+  name = "MySite"
+}
+
+resource "globalscapeeft_site_gdpr" "main" {
+  site_id = data.globalscapeeft_site.main.id
+
+  material_scope                   = "In scope"
+  territorial_scope                = "In Union, all subjects in scope (3.1)"
+  show_eu_status                   = true
+  allow_users_to_change_eu_subject = false
+  lawfulness_of_processing         = "Data subject consent (6.1.a)"
+  conditions_for_consent           = "Set via EFT's ToS or Privacy Policy"
+}
@@ -0,0 +1,12 @@
+variable "vfs_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "globalscapeeft_site_general" "main" {
+  site_id                  = "892b16dc-24a8-473f-a74e-c597b824c879"
+  root_folder              = "\\\\fileserver\\EFTRoot\\MySite\\"
+  override_vfs_credentials = true
+  vfs_login                = "EXAMPLE\\svc-eft-storage"
+  vfs_password             = var.vfs_password
+}
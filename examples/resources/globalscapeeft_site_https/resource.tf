@@ -0,0 +1,10 @@
+resource "globalscapeeft_site_https" "main" {
+  site_id                = "892b16dc-24a8-473f-a74e-c597b824c879"
+  http_enabled           = true
+  http_port              = 80
+  https_enabled          = true
+  https_port             = 443
+  redirect_http_to_https = true
+  enable_hsts            = true
+  domain                 = "files.example.com"
+}
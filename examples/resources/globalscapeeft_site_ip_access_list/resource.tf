@@ -0,0 +1,23 @@
+variable "partner_networks" {
+  description = "Partner address masks allowed to connect, in evaluation order."
+  type        = list(string)
+  default     = ["192.168.100.*", "172.16.4.*"]
+}
+
+data "globalscapeeft_site" "main" {
+  name = "MySite"
+}
+
+# Allow partner networks only; every other manually added entry is removed.
+resource "globalscapeeft_site_ip_access_list" "partners" {
+  site_id = data.globalscapeeft_site.main.id
+
+  dynamic "entry" {
+    for_each = var.partner_networks
+    content {
+      address     = entry.value
+      access_type = "Allow"
+      reason      = "Partner network"
+    }
+  }
+}
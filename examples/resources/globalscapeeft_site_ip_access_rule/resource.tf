@@ -0,0 +1,11 @@
+data "globalscapeeft_site" "main" {
+  name = "MySite"
+}
+
+# Block a single subnet while leaving the rest of the list untouched.
+resource "globalscapeeft_site_ip_access_rule" "block_guest_wifi" {
+  site_id     = data.globalscapeeft_site.main.id
+  address     = "10.99.*.*"
+  access_type = "Deny"
+  reason      = "Guest Wi-Fi"
+}
@@ -0,0 +1,21 @@
+data "globalscapeeft_site" "main" {
+  name = "MySite"
+}
+
+# Limit connections and ban clients that flood the site with bad commands.
+resource "globalscapeeft_site_network_security" "main" {
+  site_id = data.globalscapeeft_site.main.id
+
+  connection_limits = {
+    max_concurrent_logins    = 200
+    max_connections_per_user = 5
+    max_connections_per_ip   = 10
+  }
+
+  auto_ban = {
+    enabled                           = true
+    ban_if_excessive_invalid_commands = true
+    invalid_command_limit             = 5
+    sensitivity_level                 = "Medium"
+  }
+}
@@ -0,0 +1,13 @@
+data "globalscapeeft_site" "main" {
+  name = "MySite"
+}
+
+# The document is published to the EFT server separately; EFT only stores its path.
+resource "globalscapeeft_site_privacy_policy" "main" {
+  site_id = data.globalscapeeft_site.main.id
+
+  type                       = "Mandatory"
+  prevent_sftps_until_agreed = true
+  agreement_path             = "C:\\ProgramData\\Globalscape\\EFT Server\\Legal\\privacy.html"
+  agreement_label            = "I have read and accept the privacy policy"
+}
@@ -0,0 +1,23 @@
+data "globalscapeeft_site" "main" {
+  name = "MySite"
+}
+
+# Only send to known users, with short-lived links and no retention.
+resource "globalscapeeft_site_send_portal" "restricted" {
+  site_id = data.globalscapeeft_site.main.id
+
+  enabled                 = true
+  recipients              = "Users"
+  allowed_domains_enabled = true
+  allowed_domains         = ["*.example.com"]
+
+  protocol = "https"
+  port     = 443
+
+  link_expiration_value = 3
+  link_expiration_units = "Day"
+  retain_files_value    = 0
+  retain_files_units    = "Day"
+
+  file_request_enabled = false
+}
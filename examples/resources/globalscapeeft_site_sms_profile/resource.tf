@@ -0,0 +1,19 @@
+variable "twilio_auth_token" {
+  description = "Twilio auth token used for SMS passcodes."
+  type        = string
+  sensitive   = true
+}
+
+data "globalscapeeft_sites" "all" {}
+
+# Roll the same SMS gateway out to every site.
+resource "globalscapeeft_site_sms_profile" "twilio" {
+  for_each = { for s in data.globalscapeeft_sites.all.sites : s.name => s.id }
+
+  site_id             = each.value
+  name                = "Twilio"
+  account_sid         = "AC0123456789abcdef0123456789abcdef"
+  auth_token          = var.twilio_auth_token
+  credentials_version = 1
+  sender_number       = "+12105550100"
+}
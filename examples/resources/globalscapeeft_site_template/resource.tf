@@ -0,0 +1,7 @@
+data "globalscapeeft_sites" "all" {}
+
+resource "globalscapeeft_site_template" "workspaces_invite" {
+  site_id       = data.globalscapeeft_sites.all.sites[0].id
+  template_name = "WorkspacesAdminAddedParticipantMsg.html"
+  source        = "${path.module}/templates/WorkspacesAdminAddedParticipantMsg.html"
+}
@@ -0,0 +1,12 @@
+data "globalscapeeft_site" "main" {
+  name = "MySite"
+}
+
+resource "globalscapeeft_site_terms_of_service" "main" {
+  site_id = data.globalscapeeft_site.main.id
+
+  type                       = "Mandatory"
+  prevent_sftps_until_agreed = true
+  agreement_path             = "C:\\ProgramData\\Globalscape\\EFT Server\\Legal\\terms.html"
+  agreement_label            = "I accept the terms of service"
+}
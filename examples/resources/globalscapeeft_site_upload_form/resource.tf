@@ -0,0 +1,25 @@
+data "globalscapeeft_site" "main" {
+  name = "MySite"
+}
+
+resource "globalscapeeft_site_upload_form" "case_intake" {
+  site_id      = data.globalscapeeft_site.main.id
+  name         = "case-intake"
+  display_name = "Case intake"
+  description  = "Metadata required for legal case uploads"
+  instructions = "Describe the files you are uploading."
+
+  field {
+    name     = "case_number"
+    label    = "Case number"
+    type     = "String"
+    required = true
+  }
+
+  field {
+    name     = "department"
+    type     = "String"
+    required = true
+    options  = ["Legal", "Finance", "HR"]
+  }
+}
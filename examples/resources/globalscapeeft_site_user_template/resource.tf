@@ -0,0 +1,54 @@
+data "globalscapeeft_site" "main" {
+  name = "MySite"
+}
+
+# Site-wide baseline inherited by every template.
+resource "globalscapeeft_site_user_template" "default" {
+  site_id = data.globalscapeeft_site.main.id
+  name    = "Default Settings"
+
+  invalid_login_limit {
+    enabled                 = "yes"
+    max_count               = 5
+    period_minutes          = 5
+    action                  = "lock"
+    action_duration_minutes = 30
+  }
+
+  password_complexity {
+    enabled    = "yes"
+    min_length = 12
+  }
+}
+
+# SFTP-only partner template.
+resource "globalscapeeft_site_user_template" "partner" {
+  site_id = data.globalscapeeft_site.main.id
+  name    = "Partner - Acme"
+
+  ftp {
+    enabled = false
+  }
+
+  ftps {
+    enabled = false
+  }
+
+  http {
+    enable_http  = false
+    enable_https = false
+  }
+
+  home_folder {
+    enabled = true
+    path    = "/Usr/Acme"
+    as_root = true
+  }
+
+  settings_json = jsonencode({
+    sftp = {
+      enabled = true
+      value   = { authenticationType = "publicKey" }
+    }
+  })
+}
@@ -0,0 +1,20 @@
+data "globalscapeeft_site" "main" {
+  name = "MySite"
+}
+
+# Internal sharing only: no invitations outside the corporate domain.
+resource "globalscapeeft_site_workspaces" "regulated" {
+  site_id = data.globalscapeeft_site.main.id
+
+  enabled                 = true
+  invite_new_users        = true
+  allowed_domains_enabled = true
+  allowed_domains         = ["*.example.com"]
+
+  max_expiration_value = 30
+  max_expiration_units = "Day"
+
+  guest_expiration_enabled = true
+  guest_expiration_action  = "DeleteAccountOnly"
+  guest_expiration_days    = 7
+}
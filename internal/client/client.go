@@ -18,6 +18,9 @@ type Config struct {
 	Password           string
 	AuthType           string
 	InsecureSkipVerify bool
+	// NodeID is the default node used for node-scoped endpoints when a
+	// resource does not name one explicitly.
+	NodeID string
 }
 
 type Client struct {
@@ -27,6 +30,7 @@ type Client struct {
 	username   string
 	password   string
 	authType   string
+	nodeID     string
 }
 
 func NewClient(ctx context.Context, cfg Config) (*Client, error) {
@@ -41,6 +45,7 @@ func NewClient(ctx context.Context, cfg Config) (*Client, error) {
 		username:   cfg.Username,
 		password:   cfg.Password,
 		authType:   cfg.AuthType,
+		nodeID:     cfg.NodeID,
 	}
 
 	if err := c.authenticate(ctx, cfg.Username, cfg.Password, cfg.AuthType); err != nil {
@@ -79,7 +84,7 @@ func (c *Client) UpdateServerSMTP(ctx context.Context, smtp SMTPSettings) (*Serv
 		Data: serverPatchData{
 			Type: "server",
 			Attributes: serverPatchAttributes{
-				SMTP: smtp,
+				SMTP: &smtp,
 			},
 		},
 	}
@@ -92,6 +97,32 @@ func (c *Client) UpdateServerSMTP(ctx context.Context, smtp SMTPSettings) (*Serv
 	return &resp.Data, nil
 }
 
+// UpdateServerSettings patches only the general and listener sections of the
+// server document so that the SMTP block is left untouched.
+func (c *Client) UpdateServerSettings(ctx context.Context, general ServerGeneralUpdate, listener ListenerSettingsUpdate) (*Server, error) {
+	attrs := serverPatchAttributes{}
+	if general.EnableUtcInListings != nil {
+		attrs.General = &general
+	}
+	if listener.AdminPort != nil || listener.EnableRemoteAdministration != nil || listener.ListenIPs != nil {
+		attrs.ListenerSettings = &listener
+	}
+
+	req := serverPatchRequest{
+		Data: serverPatchData{
+			Type:       "server",
+			Attributes: attrs,
+		},
+	}
+
+	var resp serverResponse
+	if err := c.doRequest(ctx, http.MethodPatch, "/admin/v2/server", req, &resp, true); err != nil {
+		return nil, err
+	}
+
+	return &resp.Data, nil
+}
+
 func (c *Client) ListSites(ctx context.Context) ([]Site, error) {
 	var resp sitesResponse
 	if err := c.doRequest(ctx, http.MethodGet, "/admin/v2/sites", nil, &resp, true); err != nil {
@@ -198,7 +229,9 @@ type serverPatchData struct {
 }
 
 type serverPatchAttributes struct {
-	SMTP SMTPSettings `json:"smtp"`
+	General          *ServerGeneralUpdate    `json:"general,omitempty"`
+	ListenerSettings *ListenerSettingsUpdate `json:"listenerSettings,omitempty"`
+	SMTP             *SMTPSettings           `json:"smtp,omitempty"`
 }
 
 func (c *Client) doRequest(ctx context.Context, method, path string, body any, dest any, includeAuth bool) error {
@@ -293,6 +326,18 @@ type ListenerSettings struct {
 	ListenIPs                  []string `json:"listenIps"`
 }
 
+// ServerGeneralUpdate and ListenerSettingsUpdate carry only the fields set by
+// the caller; nil fields are omitted from the PATCH body.
+type ServerGeneralUpdate struct {
+	EnableUtcInListings *bool `json:"enableUtcInListings,omitempty"`
+}
+
+type ListenerSettingsUpdate struct {
+	AdminPort                  *int64   `json:"adminPort,omitempty"`
+	EnableRemoteAdministration *bool    `json:"enableRemoteAdministration,omitempty"`
+	ListenIPs                  []string `json:"listenIps,omitempty"`
+}
+
 type SMTPSettings struct {
 	Login             string `json:"login"`
 	Password          string `json:"password"`
@@ -320,6 +365,10 @@ type Site struct {
 
 type SiteAttributes struct {
 	Name string `json:"name"`
+	// The remaining fields are only returned by GET /sites/{siteId}.
+	General            SiteGeneral            `json:"general"`
+	ListenerSettings   SiteListenerSettings   `json:"listenerSettings"`
+	WorkspacesSettings SiteWorkspacesSettings `json:"workspacesSettings"`
 }
 
 type userResponse struct {
@@ -0,0 +1,97 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// newTestClient returns a client talking to handler, already authenticated.
+func newTestClient(t *testing.T, handler http.Handler) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return &Client{
+		httpClient: server.Client(),
+		baseURL:    server.URL,
+		token:      "test-token",
+	}
+}
+
+// jsonHandler answers every request with status and body.
+func jsonHandler(status int, body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}
+}
+
+func TestUpdateServerSettingsPartialBody(t *testing.T) {
+	utc, port := true, int64(1100)
+
+	tests := []struct {
+		name         string
+		general      ServerGeneralUpdate
+		listener     ListenerSettingsUpdate
+		wantSections []string
+	}{
+		{name: "nothing set", wantSections: []string{}},
+		{name: "general only", general: ServerGeneralUpdate{EnableUtcInListings: &utc}, wantSections: []string{"general"}},
+		{name: "listener only", listener: ListenerSettingsUpdate{AdminPort: &port}, wantSections: []string{"listenerSettings"}},
+		{
+			name:         "both",
+			general:      ServerGeneralUpdate{EnableUtcInListings: &utc},
+			listener:     ListenerSettingsUpdate{ListenIPs: []string{"0.0.0.0"}},
+			wantSections: []string{"general", "listenerSettings"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body struct {
+				Data struct {
+					Attributes map[string]map[string]any `json:"attributes"`
+				} `json:"data"`
+			}
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPatch || r.URL.Path != "/admin/v2/server" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					t.Errorf("decode body: %v", err)
+				}
+				jsonHandler(http.StatusOK, `{"data":{"type":"server","id":"s"}}`)(w, r)
+			})
+
+			if _, err := newTestClient(t, handler).UpdateServerSettings(context.Background(), tt.general, tt.listener); err != nil {
+				t.Fatalf("UpdateServerSettings() error: %v", err)
+			}
+
+			got := make([]string, 0, len(body.Data.Attributes))
+			for section := range body.Data.Attributes {
+				got = append(got, section)
+			}
+			sort.Strings(got)
+			if !reflect.DeepEqual(got, tt.wantSections) {
+				t.Fatalf("sections sent = %v, want %v", got, tt.wantSections)
+			}
+			// Leaving smtp out is what lets globalscapeeft_server_smtp
+			// coexist with this resource.
+			if _, ok := body.Data.Attributes["smtp"]; ok {
+				t.Fatalf("smtp must never be sent")
+			}
+			if listener, ok := body.Data.Attributes["listenerSettings"]; ok && tt.listener.AdminPort == nil {
+				if _, ok := listener["adminPort"]; ok {
+					t.Fatalf("unset adminPort sent: %v", listener)
+				}
+			}
+		})
+	}
+}
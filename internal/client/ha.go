@@ -0,0 +1,45 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// HA upgrade states reported by GET /admin/v2/server/ha/upgrade and the
+// actions accepted by the matching POST. Available as of EFT 8.1.0. The API
+// reference only shows "notInUpgrade" and "begin"; "inUpgrade" and "end" are
+// unverified counterparts.
+const (
+	HAUpgradeStateNotInUpgrade = "notInUpgrade"
+	HAUpgradeStateInUpgrade    = "inUpgrade"
+
+	HAUpgradeActionBegin = "begin"
+	HAUpgradeActionEnd   = "end"
+)
+
+func (c *Client) GetHAUpgradeState(ctx context.Context) (string, error) {
+	var resp haUpgradeStateResponse
+	if err := c.doRequest(ctx, http.MethodGet, "/admin/v2/server/ha/upgrade", nil, &resp, true); err != nil {
+		return "", err
+	}
+	return resp.Data, nil
+}
+
+func (c *Client) SetHAUpgradeState(ctx context.Context, action string) error {
+	req := haUpgradeRequest{
+		Data: haUpgradeRequestData{Action: action},
+	}
+	return c.doRequest(ctx, http.MethodPost, "/admin/v2/server/ha/upgrade", req, nil, true)
+}
+
+type haUpgradeStateResponse struct {
+	Data string `json:"data"`
+}
+
+type haUpgradeRequest struct {
+	Data haUpgradeRequestData `json:"data"`
+}
+
+type haUpgradeRequestData struct {
+	Action string `json:"action"`
+}
@@ -0,0 +1,113 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// LicenseModules lists the module identifiers accepted by the node license
+// endpoints.
+var LicenseModules = []string{
+	"AAMModule",
+	"ARMModule",
+	"AS2Module",
+	"AWModule",
+	"AccelerateModule",
+	"CloudConnectorModule",
+	"DMZGWModule",
+	"EFTServer",
+	"EnterpriseActionsWModule",
+	"FTPModule",
+	"FileTransferClientModule",
+	"FolderMonitorEventModule",
+	"HSModule",
+	"HTTPModule",
+	"PGPModule",
+	"RAModule",
+	"SSHModule",
+	"SecureFormsModule",
+	"TimerEventModule",
+	"WorkspacesModule",
+}
+
+func (c *Client) ListNodeLicenses(ctx context.Context, nodeID string) ([]License, error) {
+	var resp licensesResponse
+	path := fmt.Sprintf("/admin/v2/nodes/%s/licenses", nodeID)
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp, true); err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+func (c *Client) GetNodeLicense(ctx context.Context, nodeID, module string) (*License, error) {
+	var resp licenseResponse
+	path := fmt.Sprintf("/admin/v2/nodes/%s/licenses/%s", nodeID, module)
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp, true); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+func (c *Client) RegisterNodeLicense(ctx context.Context, nodeID, module, serialNumber string) error {
+	req := licenseRegistrationRequest{
+		Data: []licenseRegistrationData{
+			{
+				ID:         module,
+				Attributes: licenseRegistrationAttributes{SerialNumber: serialNumber},
+			},
+		},
+	}
+	path := fmt.Sprintf("/admin/v2/nodes/%s/licenses", nodeID)
+	return c.doRequest(ctx, http.MethodPost, path, req, nil, true)
+}
+
+type License struct {
+	Type       string            `json:"type"`
+	ID         string            `json:"id"`
+	Attributes LicenseAttributes `json:"attributes"`
+}
+
+// LicenseAttributes covers both spellings used by the API: the collection
+// endpoint reports registrationStatus while the per-module endpoint reports
+// registrationState. Not every EFT version reports an expiration date.
+type LicenseAttributes struct {
+	RegistrationStatus string `json:"registrationStatus,omitempty"`
+	RegistrationState  string `json:"registrationState,omitempty"`
+	ExpirationDate     string `json:"expirationDate,omitempty"`
+}
+
+func (l License) State() string {
+	if l.Attributes.RegistrationState != "" {
+		return l.Attributes.RegistrationState
+	}
+	return l.Attributes.RegistrationStatus
+}
+
+// IsRegistered reports whether the module has been activated with a serial
+// number rather than running in evaluation or expired mode.
+func (l License) IsRegistered() bool {
+	return strings.HasPrefix(strings.ToLower(l.State()), "registered")
+}
+
+type licensesResponse struct {
+	Data []License `json:"data"`
+}
+
+type licenseResponse struct {
+	Data License `json:"data"`
+}
+
+type licenseRegistrationRequest struct {
+	Data []licenseRegistrationData `json:"data"`
+}
+
+type licenseRegistrationData struct {
+	ID         string                        `json:"id"`
+	Attributes licenseRegistrationAttributes `json:"attributes"`
+}
+
+type licenseRegistrationAttributes struct {
+	SerialNumber string `json:"serialNumber"`
+}
@@ -0,0 +1,30 @@
+package client
+
+import "testing"
+
+func TestLicenseState(t *testing.T) {
+	tests := []struct {
+		name           string
+		attributes     LicenseAttributes
+		wantState      string
+		wantRegistered bool
+	}{
+		{name: "per-module spelling", attributes: LicenseAttributes{RegistrationState: "Registered"}, wantState: "Registered", wantRegistered: true},
+		{name: "collection spelling", attributes: LicenseAttributes{RegistrationStatus: "Evaluation period"}, wantState: "Evaluation period"},
+		{name: "state preferred over status", attributes: LicenseAttributes{RegistrationState: "registered (perpetual)", RegistrationStatus: "Expired"}, wantState: "registered (perpetual)", wantRegistered: true},
+		{name: "expired", attributes: LicenseAttributes{RegistrationStatus: "Expired"}, wantState: "Expired"},
+		{name: "empty", wantState: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := License{Attributes: tt.attributes}
+			if got := l.State(); got != tt.wantState {
+				t.Fatalf("State() = %q, want %q", got, tt.wantState)
+			}
+			if got := l.IsRegistered(); got != tt.wantRegistered {
+				t.Fatalf("IsRegistered() = %v, want %v", got, tt.wantRegistered)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+func (c *Client) GetServerStatus(ctx context.Context) (*ServerStatus, error) {
+	var resp ServerStatus
+	if err := c.doRequest(ctx, http.MethodGet, "/admin/v1/server/status", nil, &resp, true); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) GetServerMetrics(ctx context.Context) (*ServerMetrics, error) {
+	var resp serverMetricsResponse
+	if err := c.doRequest(ctx, http.MethodGet, "/admin/v2/server/metrics", nil, &resp, true); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+func (c *Client) GetNodeMetrics(ctx context.Context, nodeID string) (*NodeMetrics, error) {
+	var resp nodeMetricsResponse
+	path := fmt.Sprintf("/admin/v2/nodes/%s/metrics", nodeID)
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp, true); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+// Activity holds the transfer and session counters reported by the server
+// status and metrics endpoints.
+type Activity struct {
+	ActiveClientDownloadBytesPerSecond int64 `json:"activeClientDownloadBytesPerSecond"`
+	ActiveClientDownloadCount          int64 `json:"activeClientDownloadCount"`
+	ActiveClientUploadBytesPerSecond   int64 `json:"activeClientUploadBytesPerSecond"`
+	ActiveClientUploadCount            int64 `json:"activeClientUploadCount"`
+	ActiveServerDownloadBytesPerSecond int64 `json:"activeServerDownloadBytesPerSecond"`
+	ActiveServerDownloadCount          int64 `json:"activeServerDownloadCount"`
+	ActiveServerUploadBytesPerSecond   int64 `json:"activeServerUploadBytesPerSecond"`
+	ActiveServerUploadCount            int64 `json:"activeServerUploadCount"`
+	ConnectedAdminCount                int64 `json:"connectedAdminCount"`
+	ConnectedUserCount                 int64 `json:"connectedUserCount"`
+	RunningAwTaskCount                 int64 `json:"runningAwTaskCount"`
+	RunningEventRuleCount              int64 `json:"runningEventRuleCount"`
+}
+
+// ActiveTransferCount sums every in-flight client and server transfer.
+func (a Activity) ActiveTransferCount() int64 {
+	return a.ActiveClientDownloadCount + a.ActiveClientUploadCount +
+		a.ActiveServerDownloadCount + a.ActiveServerUploadCount
+}
+
+// ServerStatus is returned by the v1 status endpoint, which does not use the
+// JSON:API data envelope.
+type ServerStatus struct {
+	Nodes []NodeStatus `json:"nodes"`
+}
+
+type NodeStatus struct {
+	Activity    Activity        `json:"activity"`
+	IsInCluster bool            `json:"isInCluster"`
+	IsMaster    bool            `json:"isMaster"`
+	IsStarted   bool            `json:"isStarted"`
+	Licenses    []LicenseStatus `json:"licenses"`
+}
+
+type LicenseStatus struct {
+	Module            string `json:"module"`
+	RegistrationState string `json:"registrationState"`
+}
+
+type ServerMetrics struct {
+	Type       string   `json:"type"`
+	ID         string   `json:"id"`
+	Attributes Activity `json:"attributes"`
+}
+
+type serverMetricsResponse struct {
+	Data ServerMetrics `json:"data"`
+}
+
+type NodeMetrics struct {
+	Type       string                `json:"type"`
+	ID         string                `json:"id"`
+	Attributes NodeMetricsAttributes `json:"attributes"`
+}
+
+type NodeMetricsAttributes struct {
+	Activity    Activity `json:"activity"`
+	IsInCluster bool     `json:"isInCluster"`
+	IsMaster    bool     `json:"isMaster"`
+	IsStarted   bool     `json:"isStarted"`
+}
+
+type nodeMetricsResponse struct {
+	Data NodeMetrics `json:"data"`
+}
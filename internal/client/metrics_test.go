@@ -0,0 +1,102 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+const testActivityJSON = `{
+	"activeClientDownloadCount": 1,
+	"activeClientUploadCount": 2,
+	"activeServerDownloadCount": 3,
+	"activeServerUploadCount": 4,
+	"activeClientDownloadBytesPerSecond": 1000,
+	"connectedUserCount": 7
+}`
+
+func TestActivityActiveTransferCount(t *testing.T) {
+	tests := []struct {
+		name     string
+		activity Activity
+		want     int64
+	}{
+		{name: "idle", want: 0},
+		{name: "client only", activity: Activity{ActiveClientDownloadCount: 2, ActiveClientUploadCount: 1}, want: 3},
+		{name: "server only", activity: Activity{ActiveServerDownloadCount: 5, ActiveServerUploadCount: 4}, want: 9},
+		{
+			name: "rates and sessions ignored",
+			activity: Activity{
+				ActiveClientUploadCount:          1,
+				ActiveServerDownloadCount:        1,
+				ActiveClientUploadBytesPerSecond: 4096,
+				ConnectedUserCount:               10,
+				RunningEventRuleCount:            3,
+			},
+			want: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.activity.ActiveTransferCount(); got != tt.want {
+				t.Fatalf("ActiveTransferCount() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetServerStatus(t *testing.T) {
+	body := `{"nodes":[{"isStarted":true,"isMaster":true,"activity":` + testActivityJSON + `,"licenses":[{"module":"SFTP","registrationState":"registered"}]},{"isStarted":false}]}`
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/admin/v1/server/status" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		jsonHandler(http.StatusOK, body)(w, r)
+	})
+
+	status, err := newTestClient(t, handler).GetServerStatus(context.Background())
+	if err != nil {
+		t.Fatalf("GetServerStatus() error: %v", err)
+	}
+	if len(status.Nodes) != 2 {
+		t.Fatalf("got %d nodes, want 2", len(status.Nodes))
+	}
+	first := status.Nodes[0]
+	if !first.IsStarted || !first.IsMaster || status.Nodes[1].IsStarted {
+		t.Errorf("unexpected node flags: %+v", status.Nodes)
+	}
+	if got := first.Activity.ActiveTransferCount(); got != 10 {
+		t.Errorf("active transfers = %d, want 10", got)
+	}
+	if got := first.Activity.ConnectedUserCount; got != 7 {
+		t.Errorf("connected users = %d, want 7", got)
+	}
+	if len(first.Licenses) != 1 || first.Licenses[0].RegistrationState != "registered" {
+		t.Errorf("unexpected licenses: %+v", first.Licenses)
+	}
+}
+
+func TestGetNodeMetrics(t *testing.T) {
+	body := `{"data":{"type":"nodeMetrics","id":"node-a","attributes":{"isStarted":true,"isInCluster":true,"activity":` + testActivityJSON + `}}}`
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/admin/v2/nodes/node-a/metrics" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		jsonHandler(http.StatusOK, body)(w, r)
+	})
+
+	metrics, err := newTestClient(t, handler).GetNodeMetrics(context.Background(), "node-a")
+	if err != nil {
+		t.Fatalf("GetNodeMetrics() error: %v", err)
+	}
+	if metrics.ID != "node-a" || !metrics.Attributes.IsStarted || !metrics.Attributes.IsInCluster || metrics.Attributes.IsMaster {
+		t.Errorf("unexpected metrics: %+v", metrics)
+	}
+	if got := metrics.Attributes.Activity.ActiveTransferCount(); got != 10 {
+		t.Errorf("active transfers = %d, want 10", got)
+	}
+	if got := metrics.Attributes.Activity.ActiveClientDownloadBytesPerSecond; got != 1000 {
+		t.Errorf("download rate = %d, want 1000", got)
+	}
+}
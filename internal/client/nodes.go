@@ -0,0 +1,65 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+func (c *Client) ListNodes(ctx context.Context) ([]Node, error) {
+	var resp nodesResponse
+	if err := c.doRequest(ctx, http.MethodGet, "/admin/v2/nodes", nil, &resp, true); err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+// ResolveNodeID picks the node a node-scoped request should be sent to. An
+// explicit nodeID wins, followed by the provider-level default. Otherwise the
+// server is asked for its nodes and the only one is used; clusters with more
+// than one node require the caller to choose.
+func (c *Client) ResolveNodeID(ctx context.Context, nodeID string) (string, error) {
+	if nodeID != "" {
+		return nodeID, nil
+	}
+	if c.nodeID != "" {
+		return c.nodeID, nil
+	}
+
+	nodes, err := c.ListNodes(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	switch len(nodes) {
+	case 0:
+		return "", fmt.Errorf("globalscape EFT API returned no nodes")
+	case 1:
+		return nodes[0].ID, nil
+	default:
+		ids := make([]string, 0, len(nodes))
+		for _, n := range nodes {
+			ids = append(ids, n.ID)
+		}
+		return "", fmt.Errorf("server has %d nodes (%s); a node_id must be specified", len(nodes), strings.Join(ids, ", "))
+	}
+}
+
+type Node struct {
+	Type       string         `json:"type"`
+	ID         string         `json:"id"`
+	Attributes NodeAttributes `json:"attributes"`
+}
+
+type NodeAttributes struct {
+	IsStarted      bool     `json:"isStarted"`
+	IsInCluster    bool     `json:"isInCluster"`
+	FullDomainName string   `json:"fullDomainName"`
+	Version        string   `json:"version"`
+	HostIPs        []string `json:"hostIps"`
+}
+
+type nodesResponse struct {
+	Data []Node `json:"data"`
+}
@@ -0,0 +1,50 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestResolveNodeID(t *testing.T) {
+	twoNodes := `{"data":[{"type":"node","id":"node-a","attributes":{}},{"type":"node","id":"node-b","attributes":{}}]}`
+
+	tests := []struct {
+		name        string
+		explicit    string
+		providerID  string
+		status      int
+		body        string
+		want        string
+		wantErrPart string
+	}{
+		{name: "explicit wins", explicit: "node-x", providerID: "node-y", status: http.StatusInternalServerError, want: "node-x"},
+		{name: "provider default", providerID: "node-y", status: http.StatusInternalServerError, want: "node-y"},
+		{name: "single node", status: http.StatusOK, body: `{"data":[{"type":"node","id":"node-a","attributes":{}}]}`, want: "node-a"},
+		{name: "several nodes", status: http.StatusOK, body: twoNodes, wantErrPart: "node-a, node-b"},
+		{name: "no nodes", status: http.StatusOK, body: `{"data":[]}`, wantErrPart: "no nodes"},
+		{name: "list fails", status: http.StatusInternalServerError, body: `boom`, wantErrPart: "boom"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(t, jsonHandler(tt.status, tt.body))
+			c.nodeID = tt.providerID
+
+			got, err := c.ResolveNodeID(context.Background(), tt.explicit)
+			if tt.wantErrPart != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErrPart) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErrPart, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+func (c *Client) GetDPIAReport(ctx context.Context) (*DPIAReport, error) {
+	var raw json.RawMessage
+	if err := c.doRequest(ctx, http.MethodGet, "/admin/v2/server/reports/DPIA", nil, &raw, true); err != nil {
+		return nil, err
+	}
+
+	var resp dpiaReportResponse
+	if err := json.Unmarshal(raw, &resp); err != nil {
+		return nil, err
+	}
+
+	report := resp.Data.Attributes
+	report.Raw = raw
+	return &report, nil
+}
+
+// GetPCIComplianceReport calls the v1 endpoint, which returns the report
+// without the JSON:API data envelope.
+func (c *Client) GetPCIComplianceReport(ctx context.Context) (*PCIComplianceReport, error) {
+	var raw json.RawMessage
+	if err := c.doRequest(ctx, http.MethodGet, "/admin/v1/server/reports/PCICompliance", nil, &raw, true); err != nil {
+		return nil, err
+	}
+
+	var report PCIComplianceReport
+	if err := json.Unmarshal(raw, &report); err != nil {
+		return nil, err
+	}
+
+	report.Raw = raw
+	return &report, nil
+}
+
+type DPIAReport struct {
+	Sections []DPIASection `json:"dpia"`
+	// Raw is the full response document as returned by EFT.
+	Raw json.RawMessage `json:"-"`
+}
+
+type DPIASection struct {
+	Articles []DPIAArticle `json:"articles"`
+}
+
+type DPIAArticle struct {
+	Number      int64  `json:"num"`
+	Name        string `json:"name"`
+	Description string `json:"desc"`
+	Value       string `json:"value"`
+	Score       int64  `json:"score"`
+}
+
+type dpiaReportResponse struct {
+	Data struct {
+		Attributes DPIAReport `json:"attributes"`
+	} `json:"data"`
+}
+
+type PCIComplianceReport struct {
+	Sites []PCIComplianceSite `json:"PCICompliance"`
+	// Raw is the full response document as returned by EFT.
+	Raw json.RawMessage `json:"-"`
+}
+
+type PCIComplianceSite struct {
+	SiteID   string `json:"siteId"`
+	SiteName string `json:"siteName"`
+	Passed   int64  `json:"passed"`
+	Failed   int64  `json:"failed"`
+	Warnings int64  `json:"warnings"`
+}
@@ -0,0 +1,41 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestGetDPIAReport(t *testing.T) {
+	body := `{"data":{"type":"report","attributes":{"dpia":[{"articles":[{"num":5,"name":"Art. 5","desc":"Principles","value":"Yes","score":3}]}]}}}`
+	c := newTestClient(t, jsonHandler(http.StatusOK, body))
+
+	report, err := c.GetDPIAReport(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(report.Sections) != 1 || len(report.Sections[0].Articles) != 1 {
+		t.Fatalf("unexpected sections: %+v", report.Sections)
+	}
+	if a := report.Sections[0].Articles[0]; a.Number != 5 || a.Score != 3 || a.Description != "Principles" {
+		t.Fatalf("unexpected article: %+v", a)
+	}
+	if string(report.Raw) != body {
+		t.Fatalf("raw document not kept: %s", report.Raw)
+	}
+}
+
+// The v1 PCI endpoint has no data envelope.
+func TestGetPCIComplianceReport(t *testing.T) {
+	body := `{"PCICompliance":[{"siteId":"s1","siteName":"MySite","passed":10,"failed":1,"warnings":2}]}`
+	c := newTestClient(t, jsonHandler(http.StatusOK, body))
+
+	report, err := c.GetPCIComplianceReport(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := PCIComplianceSite{SiteID: "s1", SiteName: "MySite", Passed: 10, Failed: 1, Warnings: 2}
+	if len(report.Sites) != 1 || report.Sites[0] != want {
+		t.Fatalf("got %+v, want %+v", report.Sites, want)
+	}
+}
@@ -0,0 +1,60 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// RequireServerVersion fails when the EFT server reports a version older than
+// minimum, e.g. "8.1.0". Servers that do not report a parsable version are
+// given the benefit of the doubt.
+func (c *Client) RequireServerVersion(ctx context.Context, minimum string) error {
+	server, err := c.GetServer(ctx)
+	if err != nil {
+		return err
+	}
+
+	current, ok := parseVersion(server.Attributes.Version)
+	if !ok {
+		return nil
+	}
+	required, ok := parseVersion(minimum)
+	if !ok {
+		return fmt.Errorf("invalid minimum version %q", minimum)
+	}
+
+	for i := range required {
+		var part int
+		if i < len(current) {
+			part = current[i]
+		}
+		if part != required[i] {
+			if part < required[i] {
+				return fmt.Errorf("requires EFT %s or later, server reports %s", minimum, server.Attributes.Version)
+			}
+			return nil
+		}
+	}
+	return nil
+}
+
+// parseVersion reads the leading dotted numbers of v, so "8.1.0.14" and
+// "8.1.0 (build 14)" both parse.
+func parseVersion(v string) ([]int, bool) {
+	v = strings.TrimSpace(v)
+	if i := strings.IndexFunc(v, func(r rune) bool { return r != '.' && (r < '0' || r > '9') }); i >= 0 {
+		v = v[:i]
+	}
+
+	var parts []int
+	for _, s := range strings.Split(strings.Trim(v, "."), ".") {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, false
+		}
+		parts = append(parts, n)
+	}
+	return parts, len(parts) > 0
+}
@@ -0,0 +1,77 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseVersion(t *testing.T) {
+	tests := []struct {
+		in     string
+		want   []int
+		wantOK bool
+	}{
+		{in: "8.1.0", want: []int{8, 1, 0}, wantOK: true},
+		{in: "8.1.0.14", want: []int{8, 1, 0, 14}, wantOK: true},
+		{in: " 8.1.0 (build 14)", want: []int{8, 1, 0}, wantOK: true},
+		{in: "8.2.", want: []int{8, 2}, wantOK: true},
+		{in: "8", want: []int{8}, wantOK: true},
+		{in: ""},
+		{in: "unknown"},
+		{in: "v8.1"},
+		{in: "8..1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, ok := parseVersion(tt.in)
+			if ok != tt.wantOK || !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("parseVersion(%q) = %v, %t, want %v, %t", tt.in, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestRequireServerVersion(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		version     string
+		minimum     string
+		wantErrPart string
+	}{
+		{name: "equal", version: "8.1.0", minimum: "8.1.0"},
+		{name: "newer patch", version: "8.1.0.14", minimum: "8.1.0"},
+		{name: "newer minor", version: "8.2", minimum: "8.1.0"},
+		{name: "newer major", version: "9.0.0", minimum: "8.1.0"},
+		{name: "older minor", version: "8.0.7", minimum: "8.1.0", wantErrPart: "requires EFT 8.1.0 or later, server reports 8.0.7"},
+		{name: "shorter and older", version: "8", minimum: "8.1.0", wantErrPart: "requires EFT 8.1.0"},
+		{name: "unparsable version allowed", version: "unknown", minimum: "8.1.0"},
+		{name: "invalid minimum", version: "8.1.0", minimum: "latest", wantErrPart: "invalid minimum version"},
+		{name: "server read fails", status: http.StatusInternalServerError, minimum: "8.1.0", wantErrPart: "GET /admin/v2/server failed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := tt.status
+			if status == 0 {
+				status = http.StatusOK
+			}
+			c := newTestClient(t, jsonHandler(status, `{"data":{"type":"server","id":"s","attributes":{"version":"`+tt.version+`"}}}`))
+
+			err := c.RequireServerVersion(context.Background(), tt.minimum)
+			if tt.wantErrPart == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErrPart) {
+				t.Fatalf("expected error containing %q, got %v", tt.wantErrPart, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+func (c *Client) GetSiteAccountSecurity(ctx context.Context, siteID string) (*SiteAccountSecurity, error) {
+	var resp siteAccountSecurityResponse
+	path := fmt.Sprintf("/admin/v2/sites/%s/accountSecurity", siteID)
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp, true); err != nil {
+		return nil, err
+	}
+	return &resp.Data.Attributes, nil
+}
+
+// UpdateSiteAccountSecurity sends a partial document; nil sections and fields
+// are left unchanged by EFT.
+func (c *Client) UpdateSiteAccountSecurity(ctx context.Context, siteID string, settings SiteAccountSecurity) (*SiteAccountSecurity, error) {
+	req := siteAccountSecurityRequest{Data: siteAccountSecurityData{Attributes: settings}}
+
+	var resp siteAccountSecurityResponse
+	path := fmt.Sprintf("/admin/v2/sites/%s/accountSecurity", siteID)
+	if err := c.doRequest(ctx, http.MethodPatch, path, req, &resp, true); err != nil {
+		return nil, err
+	}
+	return &resp.Data.Attributes, nil
+}
+
+type SiteAccountSecurity struct {
+	AccountPolicy *AccountPolicy `json:"accountPolicy,omitempty"`
+}
+
+type AccountPolicy struct {
+	InvalidLogin *InvalidLoginPolicy  `json:"invalidLogin,omitempty"`
+	Inactivity   *InactivityPolicy    `json:"inactivity,omitempty"`
+	Reset        *PasswordResetPolicy `json:"reset,omitempty"`
+}
+
+type InvalidLoginPolicy struct {
+	Account *InvalidLoginAccount `json:"account,omitempty"`
+	IP      *InvalidLoginIP      `json:"ip,omitempty"`
+}
+
+// InvalidLoginAccount locks out or disables an account after repeated
+// failures.
+type InvalidLoginAccount struct {
+	Enabled              *bool   `json:"enabled,omitempty"`
+	Action               *string `json:"action,omitempty"`
+	AttemptPeriodMinutes *int64  `json:"attemptPeriodMinutes,omitempty"`
+	AttemptRetries       *int64  `json:"attemptRetries,omitempty"`
+	LockoutMinutes       *int64  `json:"lockoutMinutes,omitempty"`
+}
+
+// InvalidLoginIP bans the client address after repeated failures.
+type InvalidLoginIP struct {
+	Enabled              *bool   `json:"enabled,omitempty"`
+	AttemptPeriodMinutes *int64  `json:"attemptPeriodMinutes,omitempty"`
+	AttemptRetries       *int64  `json:"attemptRetries,omitempty"`
+	IncorrectLoginType   *string `json:"inCorrectLoginType,omitempty"`
+}
+
+type InactivityPolicy struct {
+	RemoveInactiveAccounts *RemoveInactiveAccounts `json:"removeInactiveAccounts,omitempty"`
+}
+
+type RemoveInactiveAccounts struct {
+	Enabled         *bool   `json:"enabled,omitempty"`
+	Action          *string `json:"action,omitempty"`
+	MaxInactiveDays *int64  `json:"maxInactiveDays,omitempty"`
+}
+
+type PasswordResetPolicy struct {
+	Enabled                   *bool               `json:"enabled,omitempty"`
+	ForceResetAfterFirstLogin *bool               `json:"forceResetAfterFirstLogin,omitempty"`
+	Expiration                *PasswordExpiration `json:"expiration,omitempty"`
+}
+
+type PasswordExpiration struct {
+	Enabled      *bool  `json:"enabled,omitempty"`
+	DaysToExpire *int64 `json:"daysToExpire,omitempty"`
+}
+
+type siteAccountSecurityResponse struct {
+	Data siteAccountSecurityData `json:"data"`
+}
+
+type siteAccountSecurityRequest struct {
+	Data siteAccountSecurityData `json:"data"`
+}
+
+type siteAccountSecurityData struct {
+	Type       string              `json:"type,omitempty"`
+	ID         string              `json:"id,omitempty"`
+	Attributes SiteAccountSecurity `json:"attributes"`
+}
@@ -0,0 +1,145 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Content integrity control (ICAP) failure actions.
+const (
+	ICAPContinue = "Continue"
+	ICAPFail     = "Fail"
+)
+
+func (c *Client) ListSiteContentIntegrityProfiles(ctx context.Context, siteID string) ([]ContentIntegrityProfile, error) {
+	var resp contentIntegrityListResponse
+	path := fmt.Sprintf("/admin/v2/sites/%s/contentIntegrity", siteID)
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp, true); err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+func (c *Client) GetSiteContentIntegrityProfile(ctx context.Context, siteID, profileID string) (*ContentIntegrityProfile, error) {
+	var resp contentIntegrityResponse
+	if err := c.doRequest(ctx, http.MethodGet, contentIntegrityPath(siteID, profileID), nil, &resp, true); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+func (c *Client) CreateSiteContentIntegrityProfile(ctx context.Context, siteID string, attrs ContentIntegrityAttributes) (*ContentIntegrityProfile, error) {
+	req := contentIntegrityRequest{Data: contentIntegrityRequestData{Type: "contentIntegrity", Attributes: attrs}}
+
+	var resp contentIntegrityResponse
+	path := fmt.Sprintf("/admin/v2/sites/%s/contentIntegrity", siteID)
+	if err := c.doRequest(ctx, http.MethodPost, path, req, &resp, true); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+func (c *Client) UpdateSiteContentIntegrityProfile(ctx context.Context, siteID, profileID string, attrs ContentIntegrityAttributes) (*ContentIntegrityProfile, error) {
+	req := contentIntegrityRequest{Data: contentIntegrityRequestData{Type: "contentIntegrity", Attributes: attrs}}
+
+	var resp contentIntegrityResponse
+	if err := c.doRequest(ctx, http.MethodPatch, contentIntegrityPath(siteID, profileID), req, &resp, true); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+// DeleteSiteContentIntegrityProfile removes a profile. EFT answers with the
+// remaining profiles, which are discarded.
+func (c *Client) DeleteSiteContentIntegrityProfile(ctx context.Context, siteID, profileID string) error {
+	return c.doRequest(ctx, http.MethodDelete, contentIntegrityPath(siteID, profileID), nil, nil, true)
+}
+
+func contentIntegrityPath(siteID, profileID string) string {
+	return fmt.Sprintf("/admin/v2/sites/%s/contentIntegrity/%s", siteID, url.PathEscape(profileID))
+}
+
+// ContentIntegrityProfile is keyed by a GUID assigned by EFT. Event rules
+// refer to profiles by this ID.
+type ContentIntegrityProfile struct {
+	Type       string                     `json:"type"`
+	ID         string                     `json:"id"`
+	Attributes ContentIntegrityAttributes `json:"attributes"`
+}
+
+type ContentIntegrityAttributes struct {
+	ProfileName      string                `json:"profileName"`
+	Host             MaskedString          `json:"host"`
+	Port             int64                 `json:"port"`
+	Path             MaskedString          `json:"path"`
+	Mode             string                `json:"mode"`
+	ScanLimit        *ICAPScanLimit        `json:"scanLimit,omitempty"`
+	HeaderOverrides  *ICAPHeaderOverrides  `json:"headerOverrides,omitempty"`
+	ResponseHandling *ICAPResponseHandling `json:"responseHandling,omitempty"`
+}
+
+// MaskedString is a string EFT may report as a boolean instead of its value,
+// as it does for the ICAP host and path. A boolean decodes as "".
+type MaskedString string
+
+func (s *MaskedString) UnmarshalJSON(data []byte) error {
+	var v string
+	if err := json.Unmarshal(data, &v); err != nil {
+		*s = ""
+		return nil
+	}
+	*s = MaskedString(v)
+	return nil
+}
+
+type ICAPScanLimit struct {
+	Enabled bool  `json:"enabled"`
+	Bytes   int64 `json:"bytes"`
+}
+
+type ICAPHeaderOverrides struct {
+	HTTPHost             ICAPHeaderOverride `json:"httpHost"`
+	XClientIP            ICAPHeaderOverride `json:"xClientIp"`
+	XServerIP            ICAPHeaderOverride `json:"xServerIp"`
+	XSubscriberIP        ICAPHeaderOverride `json:"xSubscriberIp"`
+	XAuthenticatedUser   ICAPHeaderOverride `json:"xAuthenticatedUser"`
+	XAuthenticatedGroups ICAPHeaderOverride `json:"xAuthenticatedGroups"`
+}
+
+type ICAPHeaderOverride struct {
+	Enabled bool   `json:"enabled"`
+	Value   string `json:"value"`
+}
+
+type ICAPResponseHandling struct {
+	ContinueOnConnectivityError string           `json:"continueOnConnectivityError"`
+	ContinueOnHTTPError         string           `json:"continueOnHttpError"`
+	ContinueOnICAPViolation     string           `json:"continueOnIcapViolation"`
+	ContinueOnICAPRedaction     string           `json:"continueOnIcapRedaction"`
+	CustomHeadersToAudit        ICAPAuditHeaders `json:"customHeadersToAudit"`
+}
+
+type ICAPAuditHeaders struct {
+	Enabled bool     `json:"enabled"`
+	Headers []string `json:"headers"`
+}
+
+type contentIntegrityListResponse struct {
+	Data []ContentIntegrityProfile `json:"data"`
+}
+
+type contentIntegrityResponse struct {
+	Data ContentIntegrityProfile `json:"data"`
+}
+
+type contentIntegrityRequest struct {
+	Data contentIntegrityRequestData `json:"data"`
+}
+
+type contentIntegrityRequestData struct {
+	Type       string                     `json:"type"`
+	Attributes ContentIntegrityAttributes `json:"attributes"`
+}
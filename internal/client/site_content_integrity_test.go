@@ -0,0 +1,32 @@
+package client
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMaskedStringUnmarshal(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want MaskedString
+	}{
+		{name: "value", body: `{"host":"icap.example.com"}`, want: "icap.example.com"},
+		{name: "masked true", body: `{"host":true}`, want: ""},
+		{name: "masked false", body: `{"host":false}`, want: ""},
+		{name: "null", body: `{"host":null}`, want: ""},
+		{name: "missing", body: `{}`, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got ContentIntegrityAttributes
+			if err := json.Unmarshal([]byte(tt.body), &got); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Host != tt.want {
+				t.Fatalf("host = %q, want %q", got.Host, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// CustomCommandsMinVersion is the first EFT release exposing custom commands.
+const CustomCommandsMinVersion = "8.1.0"
+
+func (c *Client) ListSiteCustomCommands(ctx context.Context, siteID string) ([]CustomCommand, error) {
+	var resp customCommandListResponse
+	path := fmt.Sprintf("/admin/v2/sites/%s/custom-commands", siteID)
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp, true); err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+func (c *Client) GetSiteCustomCommand(ctx context.Context, siteID, commandID string) (*CustomCommand, error) {
+	var resp customCommandResponse
+	if err := c.doRequest(ctx, http.MethodGet, customCommandPath(siteID, commandID), nil, &resp, true); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+func (c *Client) CreateSiteCustomCommand(ctx context.Context, siteID string, attrs CustomCommandAttributes, permissions *CustomCommandRelationships) (*CustomCommand, error) {
+	req := customCommandRequest{Data: CustomCommand{Type: "customCommand", Attributes: attrs, Relationships: permissions}}
+
+	var resp customCommandResponse
+	path := fmt.Sprintf("/admin/v2/sites/%s/custom-commands", siteID)
+	if err := c.doRequest(ctx, http.MethodPost, path, req, &resp, true); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+func (c *Client) UpdateSiteCustomCommand(ctx context.Context, siteID, commandID string, attrs CustomCommandAttributes, permissions *CustomCommandRelationships) (*CustomCommand, error) {
+	req := customCommandRequest{Data: CustomCommand{ID: commandID, Type: "customCommand", Attributes: attrs, Relationships: permissions}}
+
+	var resp customCommandResponse
+	if err := c.doRequest(ctx, http.MethodPatch, customCommandPath(siteID, commandID), req, &resp, true); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+func (c *Client) DeleteSiteCustomCommand(ctx context.Context, siteID, commandID string) error {
+	return c.doRequest(ctx, http.MethodDelete, customCommandPath(siteID, commandID), nil, nil, true)
+}
+
+func customCommandPath(siteID, commandID string) string {
+	return fmt.Sprintf("/admin/v2/sites/%s/custom-commands/%s", siteID, url.PathEscape(commandID))
+}
+
+// CustomCommand is an FTP SITE command that runs an executable on the server.
+type CustomCommand struct {
+	Type          string                      `json:"type"`
+	ID            string                      `json:"id"`
+	Attributes    CustomCommandAttributes     `json:"attributes"`
+	Relationships *CustomCommandRelationships `json:"relationships,omitempty"`
+}
+
+// CustomCommandAttributes pairs each optional setting with its use* flag;
+// the pointer values are omitted when the flag is off.
+type CustomCommandAttributes struct {
+	Enabled                bool    `json:"enabled"`
+	Name                   string  `json:"name"`
+	Description            string  `json:"description"`
+	Executable             string  `json:"executable"`
+	Parameters             string  `json:"parameters"`
+	RedirectOutputToLog    bool    `json:"redirectOutputToLog"`
+	LogFileName            *string `json:"logFileName,omitempty"`
+	UseProcessTimeOut      bool    `json:"useProcessTimeOut"`
+	ProcessTimeOut         *int64  `json:"processTimeOut,omitempty"`
+	RedirectOutputToClient bool    `json:"redirectOutputToClient"`
+	UseMinNumOfParams      bool    `json:"useMinNumOfParams"`
+	MinNumOfParams         *int64  `json:"minNumOfParams,omitempty"`
+	MinNumOfParamsMsg      *string `json:"minNumOfParamsMsg,omitempty"`
+}
+
+// CustomCommandRelationships lists the users and permission groups allowed to
+// run the command.
+type CustomCommandRelationships struct {
+	ExecutionPermissions CustomCommandExecutionPermissions `json:"executionPermissions"`
+}
+
+type CustomCommandExecutionPermissions struct {
+	Data CustomCommandPermissions `json:"data"`
+}
+
+type CustomCommandPermissions struct {
+	Users      []ResourceIdentifier `json:"users"`
+	UserGroups []ResourceIdentifier `json:"userGroups"`
+}
+
+// ResourceIdentifier is a JSON:API reference to another object. Meta is only
+// populated by EFT.
+type ResourceIdentifier struct {
+	Type string        `json:"type"`
+	ID   string        `json:"id"`
+	Meta *ResourceMeta `json:"meta,omitempty"`
+}
+
+type ResourceMeta struct {
+	Name string `json:"name"`
+}
+
+type customCommandListResponse struct {
+	Data []CustomCommand `json:"data"`
+}
+
+type customCommandResponse struct {
+	Data CustomCommand `json:"data"`
+}
+
+type customCommandRequest struct {
+	Data CustomCommand `json:"data"`
+}
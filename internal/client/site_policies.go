@@ -0,0 +1,139 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+func (c *Client) GetSitePrivacyPolicy(ctx context.Context, siteID string) (*SiteAgreement, error) {
+	return c.getSiteAgreement(ctx, siteID, "privacyPolicy")
+}
+
+func (c *Client) UpdateSitePrivacyPolicy(ctx context.Context, siteID string, settings SiteAgreement) (*SiteAgreement, error) {
+	return c.updateSiteAgreement(ctx, siteID, "privacyPolicy", settings)
+}
+
+func (c *Client) GetSiteTermsOfService(ctx context.Context, siteID string) (*SiteAgreement, error) {
+	return c.getSiteAgreement(ctx, siteID, "termsOfService")
+}
+
+func (c *Client) UpdateSiteTermsOfService(ctx context.Context, siteID string, settings SiteAgreement) (*SiteAgreement, error) {
+	return c.updateSiteAgreement(ctx, siteID, "termsOfService", settings)
+}
+
+func (c *Client) getSiteAgreement(ctx context.Context, siteID, kind string) (*SiteAgreement, error) {
+	var resp siteAgreementResponse
+	path := fmt.Sprintf("/admin/v2/sites/%s/%s", siteID, kind)
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp, true); err != nil {
+		return nil, err
+	}
+	return &resp.Data.Attributes, nil
+}
+
+// updateSiteAgreement sends a partial document; nil fields are left unchanged
+// by EFT. kind is both the path segment and the JSON:API type.
+func (c *Client) updateSiteAgreement(ctx context.Context, siteID, kind string, settings SiteAgreement) (*SiteAgreement, error) {
+	req := siteAgreementRequest{Data: siteAgreementData{Type: kind, Attributes: settings}}
+
+	var resp siteAgreementResponse
+	path := fmt.Sprintf("/admin/v2/sites/%s/%s", siteID, kind)
+	if err := c.doRequest(ctx, http.MethodPatch, path, req, &resp, true); err != nil {
+		return nil, err
+	}
+	return &resp.Data.Attributes, nil
+}
+
+func (c *Client) GetSiteGDPR(ctx context.Context, siteID string) (*SiteGDPR, error) {
+	var resp siteGDPRResponse
+	path := fmt.Sprintf("/admin/v2/sites/%s/gdpr", siteID)
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp, true); err != nil {
+		return nil, err
+	}
+	return &resp.Data.Attributes, nil
+}
+
+// UpdateSiteGDPR sends a partial document; nil fields are left unchanged by
+// EFT.
+func (c *Client) UpdateSiteGDPR(ctx context.Context, siteID string, settings SiteGDPR) (*SiteGDPR, error) {
+	req := siteGDPRRequest{Data: siteGDPRData{Type: "gdpr", Attributes: settings}}
+
+	var resp siteGDPRResponse
+	path := fmt.Sprintf("/admin/v2/sites/%s/gdpr", siteID)
+	if err := c.doRequest(ctx, http.MethodPatch, path, req, &resp, true); err != nil {
+		return nil, err
+	}
+	return &resp.Data.Attributes, nil
+}
+
+// SiteAgreement is the shared shape of the privacy policy and terms of service
+// settings. EFT stamps EffectiveDate itself whenever the agreement changes.
+type SiteAgreement struct {
+	Type           *string          `json:"type,omitempty"`
+	Values         *AgreementValues `json:"values,omitempty"`
+	AgreementPath  *string          `json:"agreementPath,omitempty"`
+	AgreementLabel *string          `json:"agreementLabel,omitempty"`
+	EffectiveDate  *string          `json:"effectiveDate,omitempty"`
+}
+
+type AgreementValues struct {
+	PreventSftpsUntilAgreed *bool `json:"preventSftpsUntilAgreed,omitempty"`
+
+	// ImpliedForNonSubjects only exists on the privacy policy.
+	ImpliedForNonSubjects *bool `json:"impliedForNonSubjects,omitempty"`
+}
+
+// SiteGDPR holds the per-article compliance statements shown in the GDPR
+// report. Every article is a free-text status such as "In scope".
+type SiteGDPR struct {
+	MaterialScope          *string               `json:"materialScope,omitempty"`
+	TerritorialScope       *GDPRTerritorialScope `json:"territorialScope,omitempty"`
+	ProcessingPrinciples   *string               `json:"processingPrinciples,omitempty"`
+	LawfulnessOfProcessing *string               `json:"lawfulnessOfProcessing,omitempty"`
+	ConditionsForConsent   *string               `json:"conditionsForConsent,omitempty"`
+	AgeRestrictions        *string               `json:"ageRestrictions,omitempty"`
+	TransparentInformation *string               `json:"transparentInformation,omitempty"`
+	DirectCollection       *string               `json:"directCollection,omitempty"`
+	IndirectCollection     *string               `json:"indirectCollection,omitempty"`
+	RightOfAccess          *string               `json:"rightOfAccess,omitempty"`
+}
+
+type GDPRTerritorialScope struct {
+	Type  *string                    `json:"type,omitempty"`
+	Value *GDPRTerritorialScopeValue `json:"value,omitempty"`
+}
+
+// GDPRTerritorialScopeValue controls whether users see, and may change, their
+// EU data subject status (UserAttributes.IsEUDataSubject).
+type GDPRTerritorialScopeValue struct {
+	ShowEUStatus              *bool `json:"showEUStatus,omitempty"`
+	AllUsersToChangeEUSubject *bool `json:"allUsersToChangeEUSubject,omitempty"`
+}
+
+type siteAgreementResponse struct {
+	Data siteAgreementData `json:"data"`
+}
+
+type siteAgreementRequest struct {
+	Data siteAgreementData `json:"data"`
+}
+
+type siteAgreementData struct {
+	Type       string        `json:"type,omitempty"`
+	ID         string        `json:"id,omitempty"`
+	Attributes SiteAgreement `json:"attributes"`
+}
+
+type siteGDPRResponse struct {
+	Data siteGDPRData `json:"data"`
+}
+
+type siteGDPRRequest struct {
+	Data siteGDPRData `json:"data"`
+}
+
+type siteGDPRData struct {
+	Type       string   `json:"type,omitempty"`
+	ID         string   `json:"id,omitempty"`
+	Attributes SiteGDPR `json:"attributes"`
+}
@@ -0,0 +1,67 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestUpdateSiteAgreement(t *testing.T) {
+	tests := []struct {
+		name     string
+		update   func(c *Client, settings SiteAgreement) (*SiteAgreement, error)
+		wantPath string
+		wantType string
+	}{
+		{
+			name: "privacy policy",
+			update: func(c *Client, settings SiteAgreement) (*SiteAgreement, error) {
+				return c.UpdateSitePrivacyPolicy(context.Background(), "site-1", settings)
+			},
+			wantPath: "/admin/v2/sites/site-1/privacyPolicy",
+			wantType: "privacyPolicy",
+		},
+		{
+			name: "terms of service",
+			update: func(c *Client, settings SiteAgreement) (*SiteAgreement, error) {
+				return c.UpdateSiteTermsOfService(context.Background(), "site-1", settings)
+			},
+			wantPath: "/admin/v2/sites/site-1/termsOfService",
+			wantType: "termsOfService",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotPath, gotType string
+			var gotAttrs map[string]any
+			c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var req struct {
+					Data struct {
+						Type       string         `json:"type"`
+						Attributes map[string]any `json:"attributes"`
+					} `json:"data"`
+				}
+				_ = json.NewDecoder(r.Body).Decode(&req)
+				gotPath, gotType, gotAttrs = r.URL.Path, req.Data.Type, req.Data.Attributes
+				jsonHandler(http.StatusOK, `{"data":{"attributes":{"type":"Mandatory","effectiveDate":"2025-05-01"}}}`)(w, r)
+			}))
+
+			label := "I agree"
+			got, err := tt.update(c, SiteAgreement{AgreementLabel: &label})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if gotPath != tt.wantPath || gotType != tt.wantType {
+				t.Fatalf("request = %s type %q, want %s type %q", gotPath, gotType, tt.wantPath, tt.wantType)
+			}
+			if len(gotAttrs) != 1 || gotAttrs["agreementLabel"] != label {
+				t.Fatalf("attributes = %v, want only agreementLabel", gotAttrs)
+			}
+			if got.EffectiveDate == nil || *got.EffectiveDate != "2025-05-01" {
+				t.Fatalf("effective date = %v", got.EffectiveDate)
+			}
+		})
+	}
+}
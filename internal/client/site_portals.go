@@ -0,0 +1,152 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+func (c *Client) GetSiteSendPortal(ctx context.Context, siteID string) (*SiteSendPortal, error) {
+	var resp siteSendPortalResponse
+	path := fmt.Sprintf("/admin/v2/sites/%s/sendPortal", siteID)
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp, true); err != nil {
+		return nil, err
+	}
+	return &resp.Data.Attributes, nil
+}
+
+// UpdateSiteSendPortal sends a partial document; nil sections and fields are
+// left unchanged by EFT.
+func (c *Client) UpdateSiteSendPortal(ctx context.Context, siteID string, settings SiteSendPortal) (*SiteSendPortal, error) {
+	req := siteSendPortalRequest{Data: siteSendPortalData{Type: "sendPortal", Attributes: settings}}
+
+	var resp siteSendPortalResponse
+	path := fmt.Sprintf("/admin/v2/sites/%s/sendPortal", siteID)
+	if err := c.doRequest(ctx, http.MethodPatch, path, req, &resp, true); err != nil {
+		return nil, err
+	}
+	return &resp.Data.Attributes, nil
+}
+
+func (c *Client) GetSiteDropOffPortal(ctx context.Context, siteID string) (*SiteDropOffPortal, error) {
+	var resp siteDropOffPortalResponse
+	path := fmt.Sprintf("/admin/v2/sites/%s/dropOffPortal", siteID)
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp, true); err != nil {
+		return nil, err
+	}
+	return &resp.Data.Attributes, nil
+}
+
+// UpdateSiteDropOffPortal sends a partial document; nil sections and fields
+// are left unchanged by EFT.
+func (c *Client) UpdateSiteDropOffPortal(ctx context.Context, siteID string, settings SiteDropOffPortal) (*SiteDropOffPortal, error) {
+	req := siteDropOffPortalRequest{Data: siteDropOffPortalData{Type: "dropOffPortal", Attributes: settings}}
+
+	var resp siteDropOffPortalResponse
+	path := fmt.Sprintf("/admin/v2/sites/%s/dropOffPortal", siteID)
+	if err := c.doRequest(ctx, http.MethodPatch, path, req, &resp, true); err != nil {
+		return nil, err
+	}
+	return &resp.Data.Attributes, nil
+}
+
+type SiteSendPortal struct {
+	Enabled                   *bool              `json:"enabled,omitempty"`
+	Recipients                *string            `json:"recipients,omitempty"`
+	AllowedDomains            *DomainFilter      `json:"allowedDomains,omitempty"`
+	DeniedDomains             *DomainFilter      `json:"deniedDomains,omitempty"`
+	ReservedPath              *string            `json:"reservedPath,omitempty"`
+	Protocol                  *string            `json:"protocol,omitempty"`
+	HostName                  *string            `json:"hostName,omitempty"`
+	Port                      *string            `json:"port,omitempty"`
+	AutoAttachInOutlook       *OutlookAutoAttach `json:"autoAttachInOutlook,omitempty"`
+	LinkExpiration            *ExpirationPeriod  `json:"linkExpiration,omitempty"`
+	RetainFiles               *RetentionPeriod   `json:"retainFilesAfterLinkExpiration,omitempty"`
+	AllowRepliesWithFiles     *bool              `json:"allowRepliesWithFiles,omitempty"`
+	SendEntireMessageSecurely *string            `json:"sendEntireMessageSecurely,omitempty"`
+	OutOfBandPasscode         *string            `json:"outOfBandPasscode,omitempty"`
+	SecondVerification        *string            `json:"secondVerification,omitempty"`
+	FileRequestPortal         *FileRequestPortal `json:"fileRequestPortal,omitempty"`
+}
+
+// OutlookAutoAttach controls when the Outlook add-in sends attachments through
+// the Send portal instead of attaching them to the message.
+type OutlookAutoAttach struct {
+	Enabled          *bool  `json:"enabled,omitempty"`
+	WhenExceedMbytes *int64 `json:"whenExceedMbytes,omitempty"`
+}
+
+// RetentionPeriod mirrors ExpirationPeriod but EFT spells the unit field in
+// the singular.
+type RetentionPeriod struct {
+	Value *int64  `json:"value,omitempty"`
+	Unit  *string `json:"unit,omitempty"`
+}
+
+type FileRequestPortal struct {
+	Enabled      *bool   `json:"enabled,omitempty"`
+	ReservedPath *string `json:"reservedPath,omitempty"`
+}
+
+type SiteDropOffPortal struct {
+	Enabled                   *bool             `json:"enabled,omitempty"`
+	ReservedPath              *string           `json:"reservedPath,omitempty"`
+	Captcha                   *PortalCaptcha    `json:"captcha,omitempty"`
+	LinkExpiration            *ExpirationPeriod `json:"linkExpiration,omitempty"`
+	MaxMessageSize            *MaxMessageSize   `json:"maxMessageSize,omitempty"`
+	SendEntireMessageSecurely *string           `json:"sendEntireMessageSecurely,omitempty"`
+	UserEmailOption           *DropOffRecipient `json:"userEmailOption,omitempty"`
+
+	// AddressBookList is a pointer so an empty list can be sent to clear it.
+	AddressBookList *[]string `json:"addressBookList,omitempty"`
+}
+
+type PortalCaptcha struct {
+	Type  *string             `json:"type,omitempty"`
+	Value *PortalCaptchaValue `json:"value,omitempty"`
+}
+
+type PortalCaptchaValue struct {
+	SiteKey   *string `json:"siteKey,omitempty"`
+	SecretKey *string `json:"secretKey,omitempty"`
+}
+
+type MaxMessageSize struct {
+	Enabled *bool  `json:"enabled,omitempty"`
+	MBytes  *int64 `json:"mBytes,omitempty"`
+}
+
+// DropOffRecipient controls whether anonymous senders may type a recipient
+// address instead of picking one from the address book.
+type DropOffRecipient struct {
+	AllowToEnterToEmailAddress *bool         `json:"allowToEnterToEmailAddress,omitempty"`
+	AllowedDomains             *DomainFilter `json:"allowedDomains,omitempty"`
+}
+
+type siteSendPortalResponse struct {
+	Data siteSendPortalData `json:"data"`
+}
+
+type siteSendPortalRequest struct {
+	Data siteSendPortalData `json:"data"`
+}
+
+type siteSendPortalData struct {
+	Type       string         `json:"type,omitempty"`
+	ID         string         `json:"id,omitempty"`
+	Attributes SiteSendPortal `json:"attributes"`
+}
+
+type siteDropOffPortalResponse struct {
+	Data siteDropOffPortalData `json:"data"`
+}
+
+type siteDropOffPortalRequest struct {
+	Data siteDropOffPortalData `json:"data"`
+}
+
+type siteDropOffPortalData struct {
+	Type       string            `json:"type,omitempty"`
+	ID         string            `json:"id,omitempty"`
+	Attributes SiteDropOffPortal `json:"attributes"`
+}
@@ -0,0 +1,106 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+func (c *Client) GetSiteNetworkSecurity(ctx context.Context, siteID string) (*SiteNetworkSecurity, error) {
+	var resp siteNetworkSecurityResponse
+	path := fmt.Sprintf("/admin/v2/sites/%s/networkandsecurity", siteID)
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp, true); err != nil {
+		return nil, err
+	}
+	return &resp.Data.Attributes, nil
+}
+
+// UpdateSiteNetworkSecurity nests the auto-ban settings under denialOfService
+// as the PATCH endpoint expects; GET returns them at the top level.
+func (c *Client) UpdateSiteNetworkSecurity(ctx context.Context, siteID string, limits *ConnectionLimits, autoBan *AutoBanUpdate) (*SiteNetworkSecurity, error) {
+	attrs := siteNetworkSecurityPatch{ConnectionLimits: limits}
+	if autoBan != nil {
+		attrs.DenialOfService = &denialOfServicePatch{AutoBan: autoBan}
+	}
+	req := siteNetworkSecurityRequest{Data: siteNetworkSecurityPatchData{Attributes: attrs}}
+
+	var resp siteNetworkSecurityResponse
+	path := fmt.Sprintf("/admin/v2/sites/%s/networkandsecurity", siteID)
+	if err := c.doRequest(ctx, http.MethodPatch, path, req, &resp, true); err != nil {
+		return nil, err
+	}
+	return &resp.Data.Attributes, nil
+}
+
+type SiteNetworkSecurity struct {
+	ConnectionLimits ConnectionLimits `json:"connectionLimits"`
+	AutoBan          AutoBan          `json:"autoBan"`
+}
+
+// ConnectionLimits pairs each limit toggle with its value. Values are only
+// returned while the matching toggle is enabled.
+type ConnectionLimits struct {
+	MaxTransferSpeed                    bool  `json:"maxTransferSpeed"`
+	MaxTransferSpeedValue               int64 `json:"maxTransferSpeedValue,omitempty"`
+	MaxConcurrentSocketConnections      bool  `json:"maxConcurrentSocketConnections"`
+	MaxConcurrentSocketConnectionsValue int64 `json:"maxConcurrentSocketConnectionsValue,omitempty"`
+	MaxConcurrentLogins                 bool  `json:"maxConcurrentLogins"`
+	MaxConcurrentLoginsValue            int64 `json:"maxConcurrentLoginsValue,omitempty"`
+	MaxConnectionsPerUser               bool  `json:"maxConnectionsPerUser"`
+	MaxConnectionsPerUserValue          int64 `json:"maxConnectionsPerUserValue,omitempty"`
+	MaxConnectionFromSameIP             bool  `json:"maxConnectionFromSameIP"`
+	MaxConnectionFromSameIPValue        int64 `json:"maxConnectionFromSameIPValue,omitempty"`
+}
+
+type AutoBan struct {
+	Enabled bool         `json:"enabled"`
+	Value   AutoBanValue `json:"value"`
+}
+
+type AutoBanValue struct {
+	BanIfExcessiveInvalidCmdsReceived bool   `json:"banIfExcessiveInvalidCmdsReceived"`
+	BanIPsPermanently                 bool   `json:"banIpsPermanently"`
+	NumberOfInvalidCmds               int64  `json:"numberOfInvalidCmds"`
+	SensitivityLevel                  string `json:"sensitivityLevel"`
+}
+
+// AutoBanUpdate follows the PATCH sample, which spells the permanent ban flag
+// "banIpsPermenantly".
+type AutoBanUpdate struct {
+	Enabled bool               `json:"enabled"`
+	Value   AutoBanUpdateValue `json:"value"`
+}
+
+type AutoBanUpdateValue struct {
+	BanIfExcessiveInvalidCmdsReceived bool   `json:"banIfExcessiveInvalidCmdsReceived"`
+	BanIPsPermanently                 bool   `json:"banIpsPermenantly"`
+	NumberOfInvalidCmds               int64  `json:"numberOfInvalidCmds"`
+	SensitivityLevel                  string `json:"sensitivityLevel"`
+}
+
+type siteNetworkSecurityResponse struct {
+	Data siteNetworkSecurityData `json:"data"`
+}
+
+type siteNetworkSecurityData struct {
+	Type       string              `json:"type,omitempty"`
+	ID         string              `json:"id,omitempty"`
+	Attributes SiteNetworkSecurity `json:"attributes"`
+}
+
+type siteNetworkSecurityRequest struct {
+	Data siteNetworkSecurityPatchData `json:"data"`
+}
+
+type siteNetworkSecurityPatchData struct {
+	Attributes siteNetworkSecurityPatch `json:"attributes"`
+}
+
+type siteNetworkSecurityPatch struct {
+	ConnectionLimits *ConnectionLimits     `json:"connectionLimits,omitempty"`
+	DenialOfService  *denialOfServicePatch `json:"denialOfService,omitempty"`
+}
+
+type denialOfServicePatch struct {
+	AutoBan *AutoBanUpdate `json:"autoBan"`
+}
@@ -0,0 +1,105 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+func (c *Client) ListSiteSMSProfiles(ctx context.Context, siteID string) ([]SMSProfile, error) {
+	var resp smsProfileListResponse
+	path := fmt.Sprintf("/admin/v2/sites/%s/sms", siteID)
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp, true); err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+func (c *Client) GetSiteSMSProfile(ctx context.Context, siteID, name string) (*SMSProfile, error) {
+	var resp smsProfileResponse
+	if err := c.doRequest(ctx, http.MethodGet, smsProfilePath(siteID, name), nil, &resp, true); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+func (c *Client) CreateSiteSMSProfile(ctx context.Context, siteID string, attrs SMSProfileAttributes) (*SMSProfile, error) {
+	req := smsProfileRequest{Data: smsProfileRequestData{Attributes: attrs}}
+
+	var resp smsProfileResponse
+	path := fmt.Sprintf("/admin/v2/sites/%s/sms", siteID)
+	if err := c.doRequest(ctx, http.MethodPost, path, req, &resp, true); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+// UpdateSiteSMSProfile patches a profile. Empty credentials are omitted so the
+// stored values are kept.
+func (c *Client) UpdateSiteSMSProfile(ctx context.Context, siteID, name string, attrs SMSProfileAttributes) (*SMSProfile, error) {
+	req := smsProfileRequest{Data: smsProfileRequestData{Attributes: attrs}}
+
+	var resp smsProfileResponse
+	if err := c.doRequest(ctx, http.MethodPatch, smsProfilePath(siteID, name), req, &resp, true); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+// DeleteSiteSMSProfile removes a profile. EFT answers with the remaining
+// profiles, which are discarded.
+func (c *Client) DeleteSiteSMSProfile(ctx context.Context, siteID, name string) error {
+	return c.doRequest(ctx, http.MethodDelete, smsProfilePath(siteID, name), nil, nil, true)
+}
+
+func smsProfilePath(siteID, name string) string {
+	return fmt.Sprintf("/admin/v2/sites/%s/sms/%s", siteID, url.PathEscape(name))
+}
+
+// SMSProfile is keyed by its name.
+type SMSProfile struct {
+	Type       string               `json:"type"`
+	ID         string               `json:"id"`
+	Attributes SMSProfileAttributes `json:"attributes"`
+}
+
+type SMSProfileAttributes struct {
+	Name        string      `json:"name,omitempty"`
+	ServiceType string      `json:"serviceType,omitempty"`
+	Services    SMSServices `json:"services"`
+	Proxy       *SMSProxy   `json:"proxy,omitempty"`
+}
+
+type SMSServices struct {
+	AccountSid   string `json:"accountSid,omitempty"`
+	AuthToken    string `json:"authToken,omitempty"`
+	TwilioNumber string `json:"twilioNumber,omitempty"`
+	Message      string `json:"message,omitempty"`
+	PostURL      string `json:"postUrl,omitempty"`
+}
+
+type SMSProxy struct {
+	Enabled  bool   `json:"enabled"`
+	Type     string `json:"type,omitempty"`
+	HostName string `json:"hostName,omitempty"`
+	Port     int64  `json:"port,omitempty"`
+	UserName string `json:"userName,omitempty"`
+	Password string `json:"password,omitempty"`
+}
+
+type smsProfileListResponse struct {
+	Data []SMSProfile `json:"data"`
+}
+
+type smsProfileResponse struct {
+	Data SMSProfile `json:"data"`
+}
+
+type smsProfileRequest struct {
+	Data smsProfileRequestData `json:"data"`
+}
+
+type smsProfileRequestData struct {
+	Attributes SMSProfileAttributes `json:"attributes"`
+}
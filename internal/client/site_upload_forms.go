@@ -0,0 +1,100 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+func (c *Client) ListSiteUploadForms(ctx context.Context, siteID string) ([]UploadForm, error) {
+	var resp uploadFormListResponse
+	path := fmt.Sprintf("/admin/v2/sites/%s/uploadForms", siteID)
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp, true); err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+func (c *Client) GetSiteUploadForm(ctx context.Context, siteID, name string) (*UploadForm, error) {
+	var resp uploadFormResponse
+	if err := c.doRequest(ctx, http.MethodGet, uploadFormPath(siteID, name), nil, &resp, true); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+// CreateSiteUploadForm creates a form keyed by name. The POST response nests
+// the form inside its own attributes, so callers should read it back with
+// GetSiteUploadForm.
+func (c *Client) CreateSiteUploadForm(ctx context.Context, siteID, name string, attrs UploadFormAttributes) error {
+	req := uploadFormRequest{Data: uploadFormRequestData{ID: name, Attributes: attrs}}
+
+	path := fmt.Sprintf("/admin/v2/sites/%s/uploadForms", siteID)
+	return c.doRequest(ctx, http.MethodPost, path, req, nil, true)
+}
+
+func (c *Client) UpdateSiteUploadForm(ctx context.Context, siteID, name string, attrs UploadFormAttributes) (*UploadForm, error) {
+	req := uploadFormRequest{Data: uploadFormRequestData{ID: name, Type: "uploadForm", Attributes: attrs}}
+
+	var resp uploadFormResponse
+	if err := c.doRequest(ctx, http.MethodPatch, uploadFormPath(siteID, name), req, &resp, true); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+func (c *Client) DeleteSiteUploadForm(ctx context.Context, siteID, name string) error {
+	return c.doRequest(ctx, http.MethodDelete, uploadFormPath(siteID, name), nil, nil, true)
+}
+
+func uploadFormPath(siteID, name string) string {
+	return fmt.Sprintf("/admin/v2/sites/%s/uploadForms/%s", siteID, url.PathEscape(name))
+}
+
+// UploadForm is keyed by its name.
+type UploadForm struct {
+	Type       string               `json:"type"`
+	ID         string               `json:"id"`
+	Attributes UploadFormAttributes `json:"attributes"`
+}
+
+// UploadFormAttributes is always sent in full. An empty UserGroupID is sent
+// as "" so that PATCH removes the permission group.
+type UploadFormAttributes struct {
+	Description             string              `json:"description"`
+	DisplayName             string              `json:"displayName"`
+	DisplayInstruction      string              `json:"displayInstruction"`
+	Enabled                 bool                `json:"enabled"`
+	ShowForEachFileUploaded bool                `json:"showForEachFileUploaded"`
+	UserGroupID             string              `json:"userGroupId"`
+	Elements                []UploadFormElement `json:"uploadFormElements"`
+}
+
+// UploadFormElement is a single field of the form. Values holds the
+// comma-separated choices offered for the field.
+type UploadFormElement struct {
+	Name         string `json:"name"`
+	DisplayLabel string `json:"displayLabel"`
+	Type         string `json:"type"`
+	Values       string `json:"values"`
+	Required     bool   `json:"required"`
+}
+
+type uploadFormListResponse struct {
+	Data []UploadForm `json:"data"`
+}
+
+type uploadFormResponse struct {
+	Data UploadForm `json:"data"`
+}
+
+type uploadFormRequest struct {
+	Data uploadFormRequestData `json:"data"`
+}
+
+type uploadFormRequestData struct {
+	ID         string               `json:"id,omitempty"`
+	Type       string               `json:"type,omitempty"`
+	Attributes UploadFormAttributes `json:"attributes"`
+}
@@ -0,0 +1,99 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// UserTemplatesMinVersion is the first EFT release exposing user settings
+// templates.
+const UserTemplatesMinVersion = "8.1.0"
+
+// DefaultUserTemplateName is the template every site starts with. It cannot
+// be created or deleted, only updated.
+const DefaultUserTemplateName = "Default Settings"
+
+func (c *Client) ListSiteUserTemplates(ctx context.Context, siteID string) ([]UserTemplate, error) {
+	var resp userTemplateListResponse
+	path := fmt.Sprintf("/admin/v2/sites/%s/user-templates", siteID)
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp, true); err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+func (c *Client) GetSiteUserTemplate(ctx context.Context, siteID, templateID string) (*UserTemplate, error) {
+	var resp userTemplateResponse
+	if err := c.doRequest(ctx, http.MethodGet, userTemplatePath(siteID, templateID), nil, &resp, true); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+func (c *Client) CreateSiteUserTemplate(ctx context.Context, siteID string, attrs json.RawMessage) (*UserTemplate, error) {
+	req := userTemplateRequest{Data: UserTemplate{Type: "userTemplate", Attributes: attrs}}
+
+	var resp userTemplateResponse
+	path := fmt.Sprintf("/admin/v2/sites/%s/user-templates", siteID)
+	if err := c.doRequest(ctx, http.MethodPost, path, req, &resp, true); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+// UpdateSiteUserTemplate patches the template. Top-level settings that are
+// sent replace the current ones, so nested objects should be sent whole.
+func (c *Client) UpdateSiteUserTemplate(ctx context.Context, siteID, templateID string, attrs json.RawMessage) (*UserTemplate, error) {
+	req := userTemplateRequest{Data: UserTemplate{Type: "userTemplate", Attributes: attrs}}
+
+	var resp userTemplateResponse
+	if err := c.doRequest(ctx, http.MethodPatch, userTemplatePath(siteID, templateID), req, &resp, true); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+func (c *Client) DeleteSiteUserTemplate(ctx context.Context, siteID, templateID string) error {
+	return c.doRequest(ctx, http.MethodDelete, userTemplatePath(siteID, templateID), nil, nil, true)
+}
+
+func userTemplatePath(siteID, templateID string) string {
+	return fmt.Sprintf("/admin/v2/sites/%s/user-templates/%s", siteID, url.PathEscape(templateID))
+}
+
+// UserTemplate carries the settings tree as raw JSON. Many settings accept
+// either a boolean or "inherit", so the tree is not decoded into typed fields.
+type UserTemplate struct {
+	Type       string          `json:"type"`
+	ID         string          `json:"id,omitempty"`
+	Attributes json.RawMessage `json:"attributes"`
+}
+
+// Name returns the template name, or an empty string when the attributes do
+// not carry one.
+func (t UserTemplate) Name() string {
+	var attrs userTemplateName
+	if err := json.Unmarshal(t.Attributes, &attrs); err != nil {
+		return ""
+	}
+	return attrs.Name
+}
+
+type userTemplateName struct {
+	Name string `json:"name"`
+}
+
+type userTemplateListResponse struct {
+	Data []UserTemplate `json:"data"`
+}
+
+type userTemplateResponse struct {
+	Data UserTemplate `json:"data"`
+}
+
+type userTemplateRequest struct {
+	Data UserTemplate `json:"data"`
+}
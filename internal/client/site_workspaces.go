@@ -0,0 +1,95 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+func (c *Client) GetSiteWorkspaces(ctx context.Context, siteID string) (*SiteWorkspaces, error) {
+	var resp siteWorkspacesResponse
+	path := fmt.Sprintf("/admin/v2/sites/%s/workspaces", siteID)
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp, true); err != nil {
+		return nil, err
+	}
+	return resp.Data.Attributes.normalize(), nil
+}
+
+// UpdateSiteWorkspaces sends a partial document; nil sections and fields are
+// left unchanged by EFT.
+func (c *Client) UpdateSiteWorkspaces(ctx context.Context, siteID string, settings SiteWorkspaces) (*SiteWorkspaces, error) {
+	req := siteWorkspacesRequest{Data: siteWorkspacesData{Attributes: settings}}
+
+	var resp siteWorkspacesResponse
+	path := fmt.Sprintf("/admin/v2/sites/%s/workspaces", siteID)
+	if err := c.doRequest(ctx, http.MethodPatch, path, req, &resp, true); err != nil {
+		return nil, err
+	}
+	return resp.Data.Attributes.normalize(), nil
+}
+
+type SiteWorkspaces struct {
+	Enabled            *bool               `json:"enabled,omitempty"`
+	NewUsers           *WorkspacesNewUsers `json:"newUsers,omitempty"`
+	MaxExpiration      *ExpirationPeriod   `json:"maxExpiration,omitempty"`
+	SecondVerification *string             `json:"secondVerification,omitempty"`
+	GuestAccounts      *WorkspacesGuests   `json:"guestAccounts,omitempty"`
+
+	// LegacyNewUsers catches the "newUser" spelling used by some GET
+	// responses. It is folded into NewUsers and never sent.
+	LegacyNewUsers *WorkspacesNewUsers `json:"newUser,omitempty"`
+}
+
+func (s SiteWorkspaces) normalize() *SiteWorkspaces {
+	if s.NewUsers == nil {
+		s.NewUsers = s.LegacyNewUsers
+	}
+	s.LegacyNewUsers = nil
+	return &s
+}
+
+// WorkspacesNewUsers controls whether participants without an account can be
+// invited, optionally restricted by email domain.
+type WorkspacesNewUsers struct {
+	Enabled        *bool         `json:"enabled,omitempty"`
+	AllowedDomains *DomainFilter `json:"allowedDomains,omitempty"`
+	DeniedDomains  *DomainFilter `json:"deniedDomains,omitempty"`
+}
+
+// DomainFilter is an email domain allow or deny list, shared by the
+// Workspaces, Send portal and Drop-Off portal settings.
+type DomainFilter struct {
+	Enabled *bool    `json:"enabled,omitempty"`
+	List    []string `json:"list,omitempty"`
+}
+
+// ExpirationPeriod is a lifetime such as {"value": 1, "units": "Year"}.
+type ExpirationPeriod struct {
+	Value *int64  `json:"value,omitempty"`
+	Units *string `json:"units,omitempty"`
+}
+
+type WorkspacesGuests struct {
+	Expiration      *WorkspacesGuestExpiration `json:"expiration,omitempty"`
+	GrantHomeFolder *bool                      `json:"grantHomeFolder,omitempty"`
+}
+
+type WorkspacesGuestExpiration struct {
+	Enabled                  *bool   `json:"enabled,omitempty"`
+	Action                   *string `json:"action,omitempty"`
+	DaysAfterLinksExpiration *int64  `json:"daysAfterLinksExpiration,omitempty"`
+}
+
+type siteWorkspacesResponse struct {
+	Data siteWorkspacesData `json:"data"`
+}
+
+type siteWorkspacesRequest struct {
+	Data siteWorkspacesData `json:"data"`
+}
+
+type siteWorkspacesData struct {
+	Type       string         `json:"type,omitempty"`
+	ID         string         `json:"id,omitempty"`
+	Attributes SiteWorkspaces `json:"attributes"`
+}
@@ -0,0 +1,34 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestGetSiteWorkspacesNewUsersSpelling(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+	}{
+		{name: "newUsers", body: `{"data":{"attributes":{"newUsers":{"enabled":true}}}}`},
+		{name: "legacy newUser", body: `{"data":{"attributes":{"newUser":{"enabled":true}}}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(t, jsonHandler(http.StatusOK, tt.body))
+
+			got, err := c.GetSiteWorkspaces(context.Background(), "site-1")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.NewUsers == nil || got.NewUsers.Enabled == nil || !*got.NewUsers.Enabled {
+				t.Fatalf("newUsers = %+v, want enabled", got.NewUsers)
+			}
+			if got.LegacyNewUsers != nil {
+				t.Fatalf("legacy section not folded: %+v", got.LegacyNewUsers)
+			}
+		})
+	}
+}
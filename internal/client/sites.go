@@ -0,0 +1,205 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+func (c *Client) GetSite(ctx context.Context, siteID string) (*Site, error) {
+	var resp siteResponse
+	path := fmt.Sprintf("/admin/v2/sites/%s", siteID)
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp, true); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+func (c *Client) CreateSite(ctx context.Context, attrs SiteCreateAttributes) (*Site, error) {
+	req := siteCreateRequest{
+		Data: siteCreateData{
+			Type:       "site",
+			Attributes: attrs,
+		},
+	}
+
+	var resp siteResponse
+	if err := c.doRequest(ctx, http.MethodPost, "/admin/v2/sites", req, &resp, true); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+func (c *Client) GetSiteGeneral(ctx context.Context, siteID string) (*SiteGeneralSettings, error) {
+	var resp siteGeneralResponse
+	path := fmt.Sprintf("/admin/v2/sites/%s/general", siteID)
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp, true); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+func (c *Client) UpdateSiteGeneral(ctx context.Context, siteID string, attrs SiteGeneralUpdate) (*SiteGeneralSettings, error) {
+	req := siteGeneralRequest{
+		Data: siteGeneralData{
+			Type:       "siteGeneral",
+			Attributes: attrs,
+		},
+	}
+
+	var resp siteGeneralResponse
+	path := fmt.Sprintf("/admin/v2/sites/%s/general", siteID)
+	if err := c.doRequest(ctx, http.MethodPatch, path, req, &resp, true); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+// GetSitesStatus returns the per-node runtime status of every site. Like the
+// server status endpoint it is only available on v1 and has no data envelope.
+func (c *Client) GetSitesStatus(ctx context.Context) (*SitesStatus, error) {
+	var resp SitesStatus
+	if err := c.doRequest(ctx, http.MethodGet, "/admin/v1/sites/status", nil, &resp, true); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) GetSiteMetrics(ctx context.Context, siteID string) (*SiteMetrics, error) {
+	var resp siteMetricsResponse
+	path := fmt.Sprintf("/admin/v2/sites/%s/metrics", siteID)
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp, true); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+type siteResponse struct {
+	Data Site `json:"data"`
+}
+
+type siteCreateRequest struct {
+	Data siteCreateData `json:"data"`
+}
+
+type siteCreateData struct {
+	Type       string               `json:"type"`
+	Attributes SiteCreateAttributes `json:"attributes"`
+}
+
+type SiteCreateAttributes struct {
+	SiteLabel                 string `json:"siteLabel"`
+	SiteRootFolder            string `json:"siteRootFolder"`
+	PCIComplianceEnabled      bool   `json:"pciComplianceEnabled"`
+	CreateUnixStyleSubFolders bool   `json:"createUnixStyleSubFolders"`
+	CreateFoldersForNewUsers  bool   `json:"createFoldersForNewUsers"`
+}
+
+// SiteGeneral is the general section of the full site document.
+type SiteGeneral struct {
+	AuthType         string `json:"authType"`
+	RootFolder       string `json:"rootFolder"`
+	LastModifiedBy   string `json:"lastModifiedBy"`
+	LastModifiedTime int64  `json:"lastModifiedTime"`
+}
+
+type SiteListenerSettings struct {
+	AccountManagementURL string   `json:"accountManagementUrl"`
+	HTTPDomain           string   `json:"httpDomain"`
+	ListenIPs            []string `json:"listenIps"`
+	FTPS                 SiteFTPS `json:"ftps"`
+	HTTPS                SitePort `json:"https"`
+	SFTP                 SitePort `json:"sftp"`
+}
+
+type SiteFTPS struct {
+	ExplicitPort int64 `json:"explicitPort"`
+	ImplicitPort int64 `json:"implicitPort"`
+}
+
+type SitePort struct {
+	Port int64 `json:"port"`
+}
+
+type SiteWorkspacesSettings struct {
+	Enabled bool `json:"enabled"`
+}
+
+type SiteGeneralUpdate struct {
+	SiteRootFolder         *string                 `json:"siteRootFolder,omitempty"`
+	OverrideVfsCredentials *OverrideVfsCredentials `json:"overrideVfsCredentials,omitempty"`
+}
+
+type siteGeneralResponse struct {
+	Data SiteGeneralSettings `json:"data"`
+}
+
+type SiteGeneralSettings struct {
+	Type       string                        `json:"type"`
+	ID         string                        `json:"id"`
+	Attributes SiteGeneralSettingsAttributes `json:"attributes"`
+}
+
+type SiteGeneralSettingsAttributes struct {
+	SiteRootFolder         string                 `json:"siteRootFolder"`
+	OverrideVfsCredentials OverrideVfsCredentials `json:"overrideVfsCredentials"`
+}
+
+// OverrideVfsCredentials sets the account EFT uses to access the site root.
+// The password is returned obfuscated and cannot be compared with the
+// configured value.
+type OverrideVfsCredentials struct {
+	Enabled bool           `json:"enabled"`
+	Value   VfsCredentials `json:"value"`
+}
+
+type VfsCredentials struct {
+	Login    string `json:"login"`
+	Password string `json:"password,omitempty"`
+}
+
+type siteGeneralRequest struct {
+	Data siteGeneralData `json:"data"`
+}
+
+type siteGeneralData struct {
+	Type       string            `json:"type"`
+	Attributes SiteGeneralUpdate `json:"attributes"`
+}
+
+type SitesStatus struct {
+	Info []SitesStatusNode `json:"info"`
+}
+
+type SitesStatusNode struct {
+	NodeName string       `json:"nodeName"`
+	Sites    []SiteStatus `json:"sites"`
+}
+
+type SiteStatus struct {
+	ID                string   `json:"id"`
+	Name              string   `json:"name"`
+	State             string   `json:"state"`
+	DMZConnected      bool     `json:"DMZConnected"`
+	StartTime         int64    `json:"startTime"`
+	LastUserLoginTime int64    `json:"lastUserLoginTime"`
+	Activity          Activity `json:"activity"`
+}
+
+type SiteMetrics struct {
+	Type       string                `json:"type"`
+	ID         string                `json:"id"`
+	Attributes SiteMetricsAttributes `json:"attributes"`
+}
+
+type SiteMetricsAttributes struct {
+	Name                  string `json:"name"`
+	IsRunning             bool   `json:"isRunning"`
+	IsDMZGatewayConnected bool   `json:"isDMZGatewayConnected"`
+	DefinedUsers          int64  `json:"definedUsers"`
+	DisabledUserCount     int64  `json:"disabledUserCount"`
+}
+
+type siteMetricsResponse struct {
+	Data SiteMetrics `json:"data"`
+}
@@ -0,0 +1,43 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Server templates are served from the experimental API, which is not
+// versioned and may change between EFT releases.
+func (c *Client) GetServerTemplate(ctx context.Context, templateName string) (string, error) {
+	var resp templateDocument
+	path := fmt.Sprintf("/admin/experimental/server/templates/%s", templateName)
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp, true); err != nil {
+		return "", err
+	}
+	return resp.Data, nil
+}
+
+func (c *Client) UpdateServerTemplate(ctx context.Context, templateName, content string) error {
+	path := fmt.Sprintf("/admin/experimental/server/templates/%s", templateName)
+	return c.doRequest(ctx, http.MethodPatch, path, templateDocument{Data: content}, nil, true)
+}
+
+// Site templates are documented under the experimental section but are served
+// per site from the v2 API. Available as of EFT 8.1.0.
+func (c *Client) GetSiteTemplate(ctx context.Context, siteID, templateName string) (string, error) {
+	var resp templateDocument
+	path := fmt.Sprintf("/admin/v2/sites/%s/templates/%s", siteID, templateName)
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp, true); err != nil {
+		return "", err
+	}
+	return resp.Data, nil
+}
+
+func (c *Client) UpdateSiteTemplate(ctx context.Context, siteID, templateName, content string) error {
+	path := fmt.Sprintf("/admin/v2/sites/%s/templates/%s", siteID, templateName)
+	return c.doRequest(ctx, http.MethodPatch, path, templateDocument{Data: content}, nil, true)
+}
+
+type templateDocument struct {
+	Data string `json:"data"`
+}
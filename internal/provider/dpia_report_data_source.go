@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &dpiaReportDataSource{}
+
+func NewDPIAReportDataSource() datasource.DataSource {
+	return &dpiaReportDataSource{}
+}
+
+type dpiaReportDataSource struct {
+	client *client.Client
+}
+
+type dpiaReportDataSourceModel struct {
+	TotalScore types.Int64        `tfsdk:"total_score"`
+	Sections   []dpiaSectionModel `tfsdk:"sections"`
+	RawJSON    types.String       `tfsdk:"raw_json"`
+}
+
+type dpiaSectionModel struct {
+	Score    types.Int64        `tfsdk:"score"`
+	Articles []dpiaArticleModel `tfsdk:"articles"`
+}
+
+type dpiaArticleModel struct {
+	Number      types.Int64  `tfsdk:"number"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Value       types.String `tfsdk:"value"`
+	Score       types.Int64  `tfsdk:"score"`
+}
+
+func (d *dpiaReportDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dpia_report"
+}
+
+func (d *dpiaReportDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Generate the Globalscape EFT Data Protection Impact Assessment (DPIA) report.",
+		Attributes: map[string]schema.Attribute{
+			"total_score": schema.Int64Attribute{
+				MarkdownDescription: "Sum of every article score. Higher values indicate more risk.",
+				Computed:            true,
+			},
+			"sections": schema.ListNestedAttribute{
+				MarkdownDescription: "Report sections in the order returned by EFT.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"score": schema.Int64Attribute{
+							MarkdownDescription: "Sum of the article scores in the section.",
+							Computed:            true,
+						},
+						"articles": schema.ListNestedAttribute{
+							MarkdownDescription: "GDPR articles assessed in the section.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"number": schema.Int64Attribute{
+										MarkdownDescription: "GDPR article number.",
+										Computed:            true,
+									},
+									"name": schema.StringAttribute{
+										MarkdownDescription: "Check name.",
+										Computed:            true,
+									},
+									"description": schema.StringAttribute{
+										MarkdownDescription: "Explanation of the check and how to reduce its risk.",
+										Computed:            true,
+									},
+									"value": schema.StringAttribute{
+										MarkdownDescription: "Current status of the check as configured on the server.",
+										Computed:            true,
+									},
+									"score": schema.Int64Attribute{
+										MarkdownDescription: "Risk score for the check.",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"raw_json": schema.StringAttribute{
+				MarkdownDescription: "Full report document as returned by EFT.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *dpiaReportDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if c, ok := req.ProviderData.(*client.Client); ok {
+		d.client = c
+	}
+}
+
+func (d *dpiaReportDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	report, err := d.client.GetDPIAReport(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to generate DPIA report", err.Error())
+		return
+	}
+
+	raw, err := normalizeRawJSON(report.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to normalize DPIA report", err.Error())
+		return
+	}
+
+	var data dpiaReportDataSourceModel
+	var total int64
+	for _, s := range report.Sections {
+		section := dpiaSectionModel{Articles: []dpiaArticleModel{}}
+		var sectionScore int64
+		for _, a := range s.Articles {
+			section.Articles = append(section.Articles, dpiaArticleModel{
+				Number:      types.Int64Value(a.Number),
+				Name:        types.StringValue(a.Name),
+				Description: types.StringValue(a.Description),
+				Value:       types.StringValue(a.Value),
+				Score:       types.Int64Value(a.Score),
+			})
+			sectionScore += a.Score
+		}
+		section.Score = types.Int64Value(sectionScore)
+		total += sectionScore
+		data.Sections = append(data.Sections, section)
+	}
+
+	data.TotalScore = types.Int64Value(total)
+	data.RawJSON = types.StringValue(raw)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ action.Action = &haUpgradeAction{}
+var _ action.ActionWithConfigure = &haUpgradeAction{}
+
+func NewHAUpgradeAction() action.Action {
+	return &haUpgradeAction{}
+}
+
+type haUpgradeAction struct {
+	client *client.Client
+}
+
+type haUpgradeActionModel struct {
+	DesiredState types.String `tfsdk:"desired_state"`
+	Timeout      types.String `tfsdk:"timeout"`
+}
+
+func (a *haUpgradeAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ha_upgrade_state"
+}
+
+func (a *haUpgradeAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Moves a Globalscape EFT HA cluster into or out of upgrade state and waits until the server reports the requested state. Requires EFT 8.1.0 or later.",
+		Attributes: map[string]schema.Attribute{
+			"desired_state": schema.StringAttribute{
+				MarkdownDescription: "Target cluster state, `normal` or `upgrade`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(haUpgradeStateNormal, haUpgradeStateUpgrade),
+				},
+			},
+			"timeout": schema.StringAttribute{
+				MarkdownDescription: "How long to wait for the cluster to reach the desired state, as a Go duration string. Defaults to `20m`.",
+				Optional:            true,
+				Validators: []validator.String{
+					positiveDurationValidator{},
+				},
+			},
+		},
+	}
+}
+
+func (a *haUpgradeAction) Configure(_ context.Context, req action.ConfigureRequest, _ *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if c, ok := req.ProviderData.(*client.Client); ok {
+		a.client = c
+	}
+}
+
+func (a *haUpgradeAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	if a.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var config haUpgradeActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout := 20 * time.Minute
+	if v := stringValueOrEmpty(config.Timeout); v != "" {
+		parsed, err := time.ParseDuration(v)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid timeout", err.Error())
+			return
+		}
+		timeout = parsed
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	progress := func(msg string) {
+		resp.SendProgress(action.InvokeProgressEvent{Message: msg})
+	}
+
+	current, err := applyHAUpgradeState(ctx, a.client, config.DesiredState.ValueString(), progress)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to change HA upgrade state", err.Error())
+		return
+	}
+
+	progress("cluster upgrade state is now " + current)
+}
+
+// positiveDurationValidator requires a Go duration string greater than zero.
+type positiveDurationValidator struct{}
+
+func (v positiveDurationValidator) Description(_ context.Context) string {
+	return "value must be a positive Go duration, such as `30m`"
+}
+
+func (v positiveDurationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v positiveDurationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	d, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid duration", err.Error())
+		return
+	}
+	if d <= 0 {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid duration", v.Description(ctx))
+	}
+}
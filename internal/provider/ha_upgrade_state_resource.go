@@ -0,0 +1,281 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &haUpgradeStateResource{}
+var _ resource.ResourceWithConfigure = &haUpgradeStateResource{}
+var _ resource.ResourceWithImportState = &haUpgradeStateResource{}
+
+const (
+	haUpgradeStateNormal  = "normal"
+	haUpgradeStateUpgrade = "upgrade"
+)
+
+// haUpgradePollInterval is how often the upgrade state is re-read while
+// waiting for the cluster to reach the requested state.
+var haUpgradePollInterval = 5 * time.Second
+
+func NewHAUpgradeStateResource() resource.Resource {
+	return &haUpgradeStateResource{}
+}
+
+type haUpgradeStateResource struct {
+	client *client.Client
+}
+
+type haUpgradeStateResourceModel struct {
+	ID           types.String   `tfsdk:"id"`
+	DesiredState types.String   `tfsdk:"desired_state"`
+	CurrentState types.String   `tfsdk:"current_state"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *haUpgradeStateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ha_upgrade_state"
+}
+
+func (r *haUpgradeStateResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Moves a Globalscape EFT HA cluster into or out of upgrade state and waits until the server reports the requested state. Requires EFT 8.1.0 or later. Destroying this resource returns the cluster to normal state.",
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Static identifier for the singleton cluster upgrade state.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"desired_state": schema.StringAttribute{
+				MarkdownDescription: "Target cluster state, `normal` or `upgrade`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(haUpgradeStateNormal, haUpgradeStateUpgrade),
+				},
+			},
+			"current_state": schema.StringAttribute{
+				MarkdownDescription: "Raw upgrade state reported by EFT, for example `notInUpgrade`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *haUpgradeStateResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if c, ok := req.ProviderData.(*client.Client); ok {
+		r.client = c
+	}
+}
+
+func (r *haUpgradeStateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var plan haUpgradeStateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, 20*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	current, err := applyHAUpgradeState(ctx, r.client, plan.DesiredState.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to change HA upgrade state", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue("ha_upgrade")
+	plan.CurrentState = types.StringValue(current)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *haUpgradeStateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var state haUpgradeStateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, 5*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	current, err := r.client.GetHAUpgradeState(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read HA upgrade state", err.Error())
+		return
+	}
+
+	observed := haUpgradeStateFromAPI(current)
+
+	// Report the observed state so that a cluster left in the wrong state
+	// shows up as drift.
+	state.DesiredState = types.StringValue(observed)
+	state.CurrentState = types.StringValue(current)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *haUpgradeStateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var plan haUpgradeStateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, 20*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	current, err := applyHAUpgradeState(ctx, r.client, plan.DesiredState.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to change HA upgrade state", err.Error())
+		return
+	}
+
+	plan.CurrentState = types.StringValue(current)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *haUpgradeStateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var state haUpgradeStateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, 20*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Never leave the cluster stuck in upgrade state once Terraform stops
+	// managing it.
+	if _, err := applyHAUpgradeState(ctx, r.client, haUpgradeStateNormal, nil); err != nil {
+		resp.Diagnostics.AddError("Failed to return cluster to normal state", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *haUpgradeStateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// haUpgradeStateFromAPI maps the raw API state onto `normal` or `upgrade`.
+// Only `notInUpgrade` is documented, so every other state, including
+// transitional ones, counts as upgrade mode.
+func haUpgradeStateFromAPI(state string) string {
+	if state == client.HAUpgradeStateNotInUpgrade {
+		return haUpgradeStateNormal
+	}
+	return haUpgradeStateUpgrade
+}
+
+// applyHAUpgradeState requests the desired cluster state if it differs from
+// the current one and polls until EFT reports it, returning the raw API state.
+// progress, when set, is called with a short message on every poll.
+func applyHAUpgradeState(ctx context.Context, c *client.Client, desired string, progress func(string)) (string, error) {
+	current, err := c.GetHAUpgradeState(ctx)
+	if err != nil {
+		return "", err
+	}
+	if haUpgradeStateFromAPI(current) == desired {
+		return current, nil
+	}
+
+	action := client.HAUpgradeActionBegin
+	if desired == haUpgradeStateNormal {
+		action = client.HAUpgradeActionEnd
+	}
+
+	tflog.Info(ctx, "changing HA upgrade state", map[string]any{"from": current, "action": action})
+	if err := c.SetHAUpgradeState(ctx, action); err != nil {
+		return "", err
+	}
+
+	ticker := time.NewTicker(haUpgradePollInterval)
+	defer ticker.Stop()
+
+	for {
+		current, err = c.GetHAUpgradeState(ctx)
+		if err != nil {
+			return "", err
+		}
+		// The timeout error reports the last state seen.
+		if haUpgradeStateFromAPI(current) == desired {
+			return current, nil
+		}
+		if progress != nil {
+			progress(fmt.Sprintf("waiting for cluster to reach %q state (currently %q)", desired, current))
+		}
+
+		select {
+		case <-ctx.Done():
+			return "", fmt.Errorf("timed out waiting for cluster to reach %q state, last reported %q: %w", desired, current, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestHAUpgradeStateFromAPI(t *testing.T) {
+	tests := []struct {
+		state string
+		want  string
+	}{
+		{state: "notInUpgrade", want: haUpgradeStateNormal},
+		{state: "inUpgrade", want: haUpgradeStateUpgrade},
+		{state: "upgradeInProgress", want: haUpgradeStateUpgrade},
+		{state: "", want: haUpgradeStateUpgrade},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.state, func(t *testing.T) {
+			if got := haUpgradeStateFromAPI(tt.state); got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyHAUpgradeState(t *testing.T) {
+	defer func(interval time.Duration) { haUpgradePollInterval = interval }(haUpgradePollInterval)
+	haUpgradePollInterval = time.Millisecond
+
+	tests := []struct {
+		name       string
+		initial    string
+		polled     []string
+		desired    string
+		wantAction string
+		want       string
+	}{
+		{name: "already normal", initial: "notInUpgrade", desired: haUpgradeStateNormal, want: "notInUpgrade"},
+		{name: "begin waits until the state changes", initial: "notInUpgrade", polled: []string{"notInUpgrade", "inUpgrade"}, desired: haUpgradeStateUpgrade, wantAction: "begin", want: "inUpgrade"},
+		{name: "end waits through transitional state", initial: "inUpgrade", polled: []string{"leavingUpgrade", "notInUpgrade"}, desired: haUpgradeStateNormal, wantAction: "end", want: "notInUpgrade"},
+		{name: "unrecognized state counts as upgrade", initial: "mystery", desired: haUpgradeStateUpgrade, want: "mystery"},
+		{name: "unrecognized state is ended", initial: "mystery", polled: []string{"notInUpgrade"}, desired: haUpgradeStateNormal, wantAction: "end", want: "notInUpgrade"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gets atomic.Int32
+			var action atomic.Value
+			action.Store("")
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /admin/v2/server/ha/upgrade", func(w http.ResponseWriter, _ *http.Request) {
+				state := tt.initial
+				if n := int(gets.Add(1)) - 2; n >= 0 && len(tt.polled) > 0 {
+					state = tt.polled[min(n, len(tt.polled)-1)]
+				}
+				writeTestJSON(w, http.StatusOK, fmt.Sprintf(`{"data":%q}`, state))
+			})
+			mux.HandleFunc("POST /admin/v2/server/ha/upgrade", func(w http.ResponseWriter, r *http.Request) {
+				var req haUpgradeTestRequest
+				if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+					t.Errorf("decoding request: %v", err)
+				}
+				action.Store(req.Data.Action)
+				w.WriteHeader(http.StatusNoContent)
+			})
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			got, err := applyHAUpgradeState(ctx, newTestClient(t, mux), tt.desired, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+			if a := action.Load().(string); a != tt.wantAction {
+				t.Fatalf("posted action %q, want %q", a, tt.wantAction)
+			}
+		})
+	}
+}
+
+type haUpgradeTestRequest struct {
+	Data struct {
+		Action string `json:"action"`
+	} `json:"data"`
+}
+
+func TestPositiveDurationValidator(t *testing.T) {
+	tests := []struct {
+		value   types.String
+		wantErr bool
+	}{
+		{value: types.StringValue("30m")},
+		{value: types.StringNull()},
+		{value: types.StringUnknown()},
+		{value: types.StringValue("0s"), wantErr: true},
+		{value: types.StringValue("-5m"), wantErr: true},
+		{value: types.StringValue("ten minutes"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value.String(), func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("timeout"), ConfigValue: tt.value}
+			var resp validator.StringResponse
+			positiveDurationValidator{}.ValidateString(context.Background(), req, &resp)
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Fatalf("HasError() = %v, want %v: %v", resp.Diagnostics.HasError(), tt.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &nodeLicenseResource{}
+var _ resource.ResourceWithConfigure = &nodeLicenseResource{}
+var _ resource.ResourceWithImportState = &nodeLicenseResource{}
+
+func NewNodeLicenseResource() resource.Resource {
+	return &nodeLicenseResource{}
+}
+
+type nodeLicenseResource struct {
+	client *client.Client
+}
+
+type nodeLicenseResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	NodeID            types.String `tfsdk:"node_id"`
+	Module            types.String `tfsdk:"module"`
+	SerialNumber      types.String `tfsdk:"serial_number"`
+	RegistrationState types.String `tfsdk:"registration_state"`
+	Registered        types.Bool   `tfsdk:"registered"`
+	Expiry            types.String `tfsdk:"expiry"`
+}
+
+func (r *nodeLicenseResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node_license"
+}
+
+func (r *nodeLicenseResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Registers a Globalscape EFT module on a node with a serial number. EFT has no API to unregister a module, so destroying this resource only removes it from Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier in the form `<node_id>/<module>`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"node_id": schema.StringAttribute{
+				MarkdownDescription: "Node to register. Defaults to the provider `node_id`, or the only node on a single-node server.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"module": schema.StringAttribute{
+				MarkdownDescription: "Module identifier to register, for example `EFTServer` or `AS2Module`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.LicenseModules...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"serial_number": schema.StringAttribute{
+				MarkdownDescription: "Registration serial number. Changing it registers the module again with the new serial.",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"registration_state": schema.StringAttribute{
+				MarkdownDescription: "Registration state reported by EFT.",
+				Computed:            true,
+			},
+			"registered": schema.BoolAttribute{
+				MarkdownDescription: "Whether EFT reports the module as registered.",
+				Computed:            true,
+			},
+			"expiry": schema.StringAttribute{
+				MarkdownDescription: "Expiration date, when reported by the EFT version in use.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *nodeLicenseResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if c, ok := req.ProviderData.(*client.Client); ok {
+		r.client = c
+	}
+}
+
+func (r *nodeLicenseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var plan nodeLicenseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nodeID, err := r.client.ResolveNodeID(ctx, stringValueOrEmpty(plan.NodeID))
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to determine node", err.Error())
+		return
+	}
+	plan.NodeID = types.StringValue(nodeID)
+
+	if err := r.register(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("Failed to register license", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(nodeID + "/" + plan.Module.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *nodeLicenseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var state nodeLicenseResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	license, err := r.client.GetNodeLicense(ctx, state.NodeID.ValueString(), state.Module.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read license", err.Error())
+		return
+	}
+
+	state.setLicense(license)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *nodeLicenseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var plan nodeLicenseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.register(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("Failed to register license", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *nodeLicenseResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Module registrations cannot be removed via the API. Removing the resource
+	// from Terraform state only; the license remains registered on the node.
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState is refused: EFT never returns the serial number, so an
+// imported license would be registered again on the next apply.
+func (r *nodeLicenseResource) ImportState(_ context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.AddError(
+		"Import not supported",
+		"EFT does not return the serial number of a registered module, so an imported globalscapeeft_node_license could not detect serial changes and would re-register the module on the next apply. "+
+			"Declare the resource with the serial number instead; the first apply posts it to EFT.",
+	)
+}
+
+// register posts the serial number and refreshes the computed registration
+// attributes from the per-module endpoint.
+func (r *nodeLicenseResource) register(ctx context.Context, m *nodeLicenseResourceModel) error {
+	nodeID := m.NodeID.ValueString()
+	module := m.Module.ValueString()
+
+	if err := r.client.RegisterNodeLicense(ctx, nodeID, module, m.SerialNumber.ValueString()); err != nil {
+		return err
+	}
+
+	license, err := r.client.GetNodeLicense(ctx, nodeID, module)
+	if err != nil {
+		return err
+	}
+
+	m.setLicense(license)
+	return nil
+}
+
+func (m *nodeLicenseResourceModel) setLicense(license *client.License) {
+	l := newLicenseModel(*license)
+	m.RegistrationState = l.RegistrationState
+	m.Registered = l.Registered
+	m.Expiry = l.Expiry
+}
@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &nodeLicensesDataSource{}
+
+func NewNodeLicensesDataSource() datasource.DataSource {
+	return &nodeLicensesDataSource{}
+}
+
+type nodeLicensesDataSource struct {
+	client *client.Client
+}
+
+type nodeLicensesDataSourceModel struct {
+	NodeID   types.String   `tfsdk:"node_id"`
+	Licenses []licenseModel `tfsdk:"licenses"`
+}
+
+type licenseModel struct {
+	Module            types.String `tfsdk:"module"`
+	RegistrationState types.String `tfsdk:"registration_state"`
+	Registered        types.Bool   `tfsdk:"registered"`
+	Expiry            types.String `tfsdk:"expiry"`
+}
+
+func (d *nodeLicensesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node_licenses"
+}
+
+func (d *nodeLicensesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the license registration state of every module on a Globalscape EFT node.",
+		Attributes: map[string]schema.Attribute{
+			"node_id": schema.StringAttribute{
+				MarkdownDescription: "Node identifier. Defaults to the provider `node_id`, or the only node on a single-node server.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"licenses": schema.ListNestedAttribute{
+				MarkdownDescription: "Licensed modules on the node.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"module": schema.StringAttribute{
+							MarkdownDescription: "Module identifier, for example `EFTServer` or `AS2Module`.",
+							Computed:            true,
+						},
+						"registration_state": schema.StringAttribute{
+							MarkdownDescription: "Registration state reported by EFT, for example `Evaluation period`.",
+							Computed:            true,
+						},
+						"registered": schema.BoolAttribute{
+							MarkdownDescription: "Whether the module is registered with a serial number.",
+							Computed:            true,
+						},
+						"expiry": schema.StringAttribute{
+							MarkdownDescription: "Expiration date, when reported by the EFT version in use.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *nodeLicensesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if c, ok := req.ProviderData.(*client.Client); ok {
+		d.client = c
+	}
+}
+
+func (d *nodeLicensesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var data nodeLicensesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nodeID, err := d.client.ResolveNodeID(ctx, stringValueOrEmpty(data.NodeID))
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to determine node", err.Error())
+		return
+	}
+
+	licenses, err := d.client.ListNodeLicenses(ctx, nodeID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to list node licenses", err.Error())
+		return
+	}
+
+	data.NodeID = types.StringValue(nodeID)
+	data.Licenses = nil
+	for _, l := range licenses {
+		data.Licenses = append(data.Licenses, newLicenseModel(l))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func newLicenseModel(l client.License) licenseModel {
+	expiry := types.StringNull()
+	if l.Attributes.ExpirationDate != "" {
+		expiry = types.StringValue(l.Attributes.ExpirationDate)
+	}
+
+	return licenseModel{
+		Module:            types.StringValue(l.ID),
+		RegistrationState: types.StringValue(l.State()),
+		Registered:        types.BoolValue(l.IsRegistered()),
+		Expiry:            expiry,
+	}
+}
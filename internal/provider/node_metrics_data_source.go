@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &nodeMetricsDataSource{}
+
+func NewNodeMetricsDataSource() datasource.DataSource {
+	return &nodeMetricsDataSource{}
+}
+
+type nodeMetricsDataSource struct {
+	client *client.Client
+}
+
+type nodeMetricsDataSourceModel struct {
+	NodeID      types.String  `tfsdk:"node_id"`
+	IsInCluster types.Bool    `tfsdk:"is_in_cluster"`
+	IsMaster    types.Bool    `tfsdk:"is_master"`
+	IsStarted   types.Bool    `tfsdk:"is_started"`
+	Activity    activityModel `tfsdk:"activity"`
+}
+
+func (d *nodeMetricsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node_metrics"
+}
+
+func (d *nodeMetricsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetch transfer metrics and state for a single Globalscape EFT node.",
+		Attributes: map[string]schema.Attribute{
+			"node_id": schema.StringAttribute{
+				MarkdownDescription: "Node identifier (typically the host name) as returned by the nodes endpoint. Defaults to the provider `node_id`, or the only node on a single-node server.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"is_in_cluster": schema.BoolAttribute{Computed: true},
+			"is_master":     schema.BoolAttribute{Computed: true},
+			"is_started":    schema.BoolAttribute{Computed: true},
+			"activity": schema.SingleNestedAttribute{
+				MarkdownDescription: "Transfer and session counters for the node.",
+				Computed:            true,
+				Attributes:          activitySchemaAttributes(),
+			},
+		},
+	}
+}
+
+func (d *nodeMetricsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if c, ok := req.ProviderData.(*client.Client); ok {
+		d.client = c
+	}
+}
+
+func (d *nodeMetricsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var data nodeMetricsDataSourceModel
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("node_id"), &data.NodeID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nodeID, err := d.client.ResolveNodeID(ctx, stringValueOrEmpty(data.NodeID))
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to determine node", err.Error())
+		return
+	}
+
+	metrics, err := d.client.GetNodeMetrics(ctx, nodeID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to query node metrics", err.Error())
+		return
+	}
+
+	data = newNodeMetricsModel(nodeID, metrics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// newNodeMetricsModel records the resolved node ID so a provider-default node
+// is visible in state.
+func newNodeMetricsModel(nodeID string, metrics *client.NodeMetrics) nodeMetricsDataSourceModel {
+	return nodeMetricsDataSourceModel{
+		NodeID:      types.StringValue(nodeID),
+		IsInCluster: types.BoolValue(metrics.Attributes.IsInCluster),
+		IsMaster:    types.BoolValue(metrics.Attributes.IsMaster),
+		IsStarted:   types.BoolValue(metrics.Attributes.IsStarted),
+		Activity:    newActivityModel(metrics.Attributes.Activity),
+	}
+}
@@ -0,0 +1,30 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNewNodeMetricsModel(t *testing.T) {
+	metrics := &client.NodeMetrics{ID: "node-a"}
+	metrics.Attributes.IsStarted = true
+	metrics.Attributes.IsMaster = true
+	metrics.Attributes.Activity = client.Activity{ActiveClientUploadCount: 1, ActiveServerDownloadCount: 2, ConnectedAdminCount: 1}
+
+	got := newNodeMetricsModel("node-a", metrics)
+
+	if got.NodeID.ValueString() != "node-a" {
+		t.Errorf("node_id = %s, want node-a", got.NodeID)
+	}
+	if !got.IsStarted.ValueBool() || !got.IsMaster.ValueBool() || got.IsInCluster.ValueBool() {
+		t.Errorf("flags = %s/%s/%s", got.IsStarted, got.IsMaster, got.IsInCluster)
+	}
+	if !got.Activity.ActiveTransferCount.Equal(types.Int64Value(3)) {
+		t.Errorf("activity.active_transfer_count = %s, want 3", got.Activity.ActiveTransferCount)
+	}
+	if !got.Activity.ConnectedAdminCount.Equal(types.Int64Value(1)) {
+		t.Errorf("activity.connected_admin_count = %s, want 1", got.Activity.ConnectedAdminCount)
+	}
+}
@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &nodesDataSource{}
+
+func NewNodesDataSource() datasource.DataSource {
+	return &nodesDataSource{}
+}
+
+type nodesDataSource struct {
+	client *client.Client
+}
+
+type nodesDataSourceModel struct {
+	Nodes []nodeModel `tfsdk:"nodes"`
+}
+
+type nodeModel struct {
+	ID          types.String `tfsdk:"id"`
+	Hostname    types.String `tfsdk:"hostname"`
+	Role        types.String `tfsdk:"role"`
+	State       types.String `tfsdk:"state"`
+	Version     types.String `tfsdk:"version"`
+	HostIPs     types.List   `tfsdk:"host_ips"`
+	IsInCluster types.Bool   `tfsdk:"is_in_cluster"`
+	IsStarted   types.Bool   `tfsdk:"is_started"`
+}
+
+func (d *nodesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_nodes"
+}
+
+func (d *nodesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the Globalscape EFT nodes, including HA cluster members.",
+		Attributes: map[string]schema.Attribute{
+			"nodes": schema.ListNestedAttribute{
+				MarkdownDescription: "Nodes reported by the server.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Node identifier used in node-scoped endpoints.",
+							Computed:            true,
+						},
+						"hostname": schema.StringAttribute{
+							MarkdownDescription: "Fully qualified domain name of the node.",
+							Computed:            true,
+						},
+						"role": schema.StringAttribute{
+							MarkdownDescription: "`standalone`, `master` or `member`.",
+							Computed:            true,
+						},
+						"state": schema.StringAttribute{
+							MarkdownDescription: "`started` or `stopped`.",
+							Computed:            true,
+						},
+						"version": schema.StringAttribute{
+							MarkdownDescription: "EFT version running on the node.",
+							Computed:            true,
+						},
+						"host_ips": schema.ListAttribute{
+							MarkdownDescription: "IP addresses bound on the node.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"is_in_cluster": schema.BoolAttribute{Computed: true},
+						"is_started":    schema.BoolAttribute{Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *nodesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if c, ok := req.ProviderData.(*client.Client); ok {
+		d.client = c
+	}
+}
+
+func (d *nodesDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	nodes, err := d.client.ListNodes(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to list nodes", err.Error())
+		return
+	}
+
+	var state nodesDataSourceModel
+	for _, n := range nodes {
+		hostIPs, diags := types.ListValueFrom(ctx, types.StringType, n.Attributes.HostIPs)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// The nodes endpoint does not report which member is the master, so
+		// cluster members are looked up individually.
+		role := "standalone"
+		if n.Attributes.IsInCluster {
+			metrics, err := d.client.GetNodeMetrics(ctx, n.ID)
+			if err != nil {
+				resp.Diagnostics.AddError("Unable to query node metrics", err.Error())
+				return
+			}
+			role = "member"
+			if metrics.Attributes.IsMaster {
+				role = "master"
+			}
+		}
+
+		nodeState := "stopped"
+		if n.Attributes.IsStarted {
+			nodeState = "started"
+		}
+
+		state.Nodes = append(state.Nodes, nodeModel{
+			ID:          types.StringValue(n.ID),
+			Hostname:    types.StringValue(n.Attributes.FullDomainName),
+			Role:        types.StringValue(role),
+			State:       types.StringValue(nodeState),
+			Version:     types.StringValue(n.Attributes.Version),
+			HostIPs:     hostIPs,
+			IsInCluster: types.BoolValue(n.Attributes.IsInCluster),
+			IsStarted:   types.BoolValue(n.Attributes.IsStarted),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
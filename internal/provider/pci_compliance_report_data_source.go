@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &pciComplianceReportDataSource{}
+
+func NewPCIComplianceReportDataSource() datasource.DataSource {
+	return &pciComplianceReportDataSource{}
+}
+
+type pciComplianceReportDataSource struct {
+	client *client.Client
+}
+
+type pciComplianceReportDataSourceModel struct {
+	TotalPassed   types.Int64              `tfsdk:"total_passed"`
+	TotalFailed   types.Int64              `tfsdk:"total_failed"`
+	TotalWarnings types.Int64              `tfsdk:"total_warnings"`
+	Sites         []pciComplianceSiteModel `tfsdk:"sites"`
+	RawJSON       types.String             `tfsdk:"raw_json"`
+}
+
+type pciComplianceSiteModel struct {
+	SiteID   types.String `tfsdk:"site_id"`
+	SiteName types.String `tfsdk:"site_name"`
+	Status   types.String `tfsdk:"status"`
+	Passed   types.Int64  `tfsdk:"passed"`
+	Failed   types.Int64  `tfsdk:"failed"`
+	Warnings types.Int64  `tfsdk:"warnings"`
+}
+
+func (d *pciComplianceReportDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pci_compliance_report"
+}
+
+func (d *pciComplianceReportDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Generate the Globalscape EFT PCI DSS compliance report.",
+		Attributes: map[string]schema.Attribute{
+			"total_passed": schema.Int64Attribute{
+				MarkdownDescription: "Passed checks across all sites.",
+				Computed:            true,
+			},
+			"total_failed": schema.Int64Attribute{
+				MarkdownDescription: "Failed checks across all sites.",
+				Computed:            true,
+			},
+			"total_warnings": schema.Int64Attribute{
+				MarkdownDescription: "Checks raising a warning across all sites.",
+				Computed:            true,
+			},
+			"sites": schema.ListNestedAttribute{
+				MarkdownDescription: "Per-site check results.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"site_id":   schema.StringAttribute{Computed: true},
+						"site_name": schema.StringAttribute{Computed: true},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "`failed` when any check failed, `warning` when only warnings were raised, otherwise `passed`.",
+							Computed:            true,
+						},
+						"passed":   schema.Int64Attribute{Computed: true},
+						"failed":   schema.Int64Attribute{Computed: true},
+						"warnings": schema.Int64Attribute{Computed: true},
+					},
+				},
+			},
+			"raw_json": schema.StringAttribute{
+				MarkdownDescription: "Full report document as returned by EFT.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *pciComplianceReportDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if c, ok := req.ProviderData.(*client.Client); ok {
+		d.client = c
+	}
+}
+
+func (d *pciComplianceReportDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	report, err := d.client.GetPCIComplianceReport(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to generate PCI compliance report", err.Error())
+		return
+	}
+
+	raw, err := normalizeRawJSON(report.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to normalize PCI compliance report", err.Error())
+		return
+	}
+
+	var data pciComplianceReportDataSourceModel
+	var passed, failed, warnings int64
+	for _, s := range report.Sites {
+		data.Sites = append(data.Sites, pciComplianceSiteModel{
+			SiteID:   types.StringValue(s.SiteID),
+			SiteName: types.StringValue(s.SiteName),
+			Status:   types.StringValue(pciComplianceStatus(s)),
+			Passed:   types.Int64Value(s.Passed),
+			Failed:   types.Int64Value(s.Failed),
+			Warnings: types.Int64Value(s.Warnings),
+		})
+		passed += s.Passed
+		failed += s.Failed
+		warnings += s.Warnings
+	}
+
+	data.TotalPassed = types.Int64Value(passed)
+	data.TotalFailed = types.Int64Value(failed)
+	data.TotalWarnings = types.Int64Value(warnings)
+	data.RawJSON = types.StringValue(raw)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// pciComplianceStatus summarizes a site: any failed check fails the site,
+// otherwise any warning flags it.
+func pciComplianceStatus(s client.PCIComplianceSite) string {
+	switch {
+	case s.Failed > 0:
+		return "failed"
+	case s.Warnings > 0:
+		return "warning"
+	default:
+		return "passed"
+	}
+}
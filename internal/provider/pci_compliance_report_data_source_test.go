@@ -0,0 +1,28 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+)
+
+func TestPCIComplianceStatus(t *testing.T) {
+	tests := []struct {
+		name string
+		site client.PCIComplianceSite
+		want string
+	}{
+		{name: "all passed", site: client.PCIComplianceSite{Passed: 12}, want: "passed"},
+		{name: "warnings only", site: client.PCIComplianceSite{Passed: 10, Warnings: 2}, want: "warning"},
+		{name: "failure wins over warnings", site: client.PCIComplianceSite{Passed: 9, Failed: 1, Warnings: 2}, want: "failed"},
+		{name: "empty report", want: "passed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := pciComplianceStatus(tt.site); got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
 	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/version"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -16,6 +17,7 @@ import (
 )
 
 var _ provider.Provider = &globalscapeProvider{}
+var _ provider.ProviderWithActions = &globalscapeProvider{}
 
 func New() provider.Provider {
 	return &globalscapeProvider{}
@@ -34,6 +36,7 @@ type providerModel struct {
 	Password           types.String `tfsdk:"password"`
 	AuthType           types.String `tfsdk:"auth_type"`
 	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	NodeID             types.String `tfsdk:"node_id"`
 }
 
 func (p *globalscapeProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
@@ -61,6 +64,10 @@ func (p *globalscapeProvider) Schema(_ context.Context, _ provider.SchemaRequest
 				MarkdownDescription: "Skip TLS verification when communicating with EFT. Useful for lab systems with self-signed certificates.",
 				Optional:            true,
 			},
+			"node_id": schema.StringAttribute{
+				MarkdownDescription: "Default node for node-scoped endpoints (such as node metrics and licenses) in an HA cluster. When unset and the server has a single node, that node is used.",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -111,6 +118,7 @@ func (p *globalscapeProvider) Configure(ctx context.Context, req provider.Config
 		Password:           password,
 		AuthType:           authType,
 		InsecureSkipVerify: insecure,
+		NodeID:             strings.TrimSpace(config.NodeID.ValueString()),
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to initialize client", err.Error())
@@ -121,19 +129,61 @@ func (p *globalscapeProvider) Configure(ctx context.Context, req provider.Config
 
 	resp.DataSourceData = apiClient
 	resp.ResourceData = apiClient
+	resp.ActionData = apiClient
 }
 
 func (p *globalscapeProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewServerSMTPResource,
+		NewServerSettingsResource,
 		NewSiteUserResource,
 		NewEventRuleResource,
+		NewHAUpgradeStateResource,
+		NewNodeLicenseResource,
+		NewServerTemplateResource,
+		NewSiteTemplateResource,
+		NewSiteResource,
+		NewSiteAuthenticationResource,
+		NewSiteGeneralResource,
+		NewSiteFTPSResource,
+		NewSiteHTTPSResource,
+		NewSiteNetworkSecurityResource,
+		NewSiteIPAccessRuleResource,
+		NewSiteIPAccessListResource,
+		NewSiteSMSProfileResource,
+		NewSiteAccountSecurityResource,
+		NewSiteWorkspacesResource,
+		NewSiteSendPortalResource,
+		NewSiteDropOffPortalResource,
+		NewSitePrivacyPolicyResource,
+		NewSiteTermsOfServiceResource,
+		NewSiteGDPRResource,
+		NewSiteUploadFormResource,
+		NewSiteContentIntegrityProfileResource,
+		NewSiteCustomCommandResource,
+		NewSiteUserTemplateResource,
 	}
 }
 
 func (p *globalscapeProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewServerDataSource,
+		NewServerStatusDataSource,
+		NewServerMetricsDataSource,
+		NewNodesDataSource,
+		NewNodeMetricsDataSource,
+		NewNodeLicensesDataSource,
+		NewDPIAReportDataSource,
+		NewPCIComplianceReportDataSource,
 		NewSitesDataSource,
+		NewSiteDataSource,
+		NewSiteIPAutoBansDataSource,
+	}
+}
+
+func (p *globalscapeProvider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		NewHAUpgradeAction,
+		NewSiteIPUnbanAction,
 	}
 }
@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
+	"time"
 
 	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
@@ -36,6 +38,960 @@ data "globalscapeeft_sites" "all" {}
 	})
 }
 
+func TestAccServerMetricsDataSource_basic(t *testing.T) {
+	testAccPreCheck(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+data "globalscapeeft_server_metrics" "current" {}
+data "globalscapeeft_server_status" "current" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.globalscapeeft_server_metrics.current", "active_transfer_count"),
+					resource.TestCheckResourceAttrSet("data.globalscapeeft_server_status.current", "nodes.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNodesDataSource_basic(t *testing.T) {
+	testAccPreCheck(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+data "globalscapeeft_nodes" "all" {}
+
+data "globalscapeeft_node_metrics" "first" {
+  node_id = data.globalscapeeft_nodes.all.nodes[0].id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.globalscapeeft_nodes.all", "nodes.0.id"),
+					resource.TestCheckResourceAttrSet("data.globalscapeeft_nodes.all", "nodes.0.role"),
+					resource.TestCheckResourceAttrPair("data.globalscapeeft_node_metrics.first", "node_id", "data.globalscapeeft_nodes.all", "nodes.0.id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccHAUpgradeState_basic(t *testing.T) {
+	testAccPreCheck(t)
+	if os.Getenv("EFT_TEST_HA") != "true" {
+		t.Skip("EFT_TEST_HA must be set to true to move the test cluster into upgrade state")
+	}
+
+	resourceName := "globalscapeeft_ha_upgrade_state.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHAUpgradeStateConfig("upgrade"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "current_state", "inUpgrade"),
+			},
+			{
+				Config: testAccHAUpgradeStateConfig("normal"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "current_state", "notInUpgrade"),
+			},
+		},
+	})
+}
+
+func TestAccServerSettings_basic(t *testing.T) {
+	testAccPreCheck(t)
+
+	c, err := testAccClient()
+	if err != nil {
+		t.Fatalf("create client: %v", err)
+	}
+	before, err := c.GetServer(context.Background())
+	if err != nil {
+		t.Fatalf("read server: %v", err)
+	}
+	// Re-apply the current value so the test leaves the server as it found it.
+	utc := before.Attributes.General.EnableUtcInListings
+
+	resourceName := "globalscapeeft_server_settings.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerSettingsConfig(utc),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enable_utc_in_listings", fmt.Sprint(utc)),
+					resource.TestCheckResourceAttr(resourceName, "admin_port", fmt.Sprint(before.Attributes.ListenerSettings.AdminPort)),
+					testAccCheckServerSMTPUnchanged(before.Attributes.SMTP),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNodeLicensesDataSource_basic(t *testing.T) {
+	testAccPreCheck(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+data "globalscapeeft_nodes" "all" {}
+
+data "globalscapeeft_node_licenses" "first" {
+  node_id = data.globalscapeeft_nodes.all.nodes[0].id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.globalscapeeft_node_licenses.first", "licenses.0.module"),
+					resource.TestCheckResourceAttrSet("data.globalscapeeft_node_licenses.first", "licenses.0.registration_state"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNodeLicense_basic(t *testing.T) {
+	testAccPreCheck(t)
+	module := os.Getenv("EFT_TEST_LICENSE_MODULE")
+	serial := os.Getenv("EFT_TEST_LICENSE_SERIAL")
+	if module == "" || serial == "" {
+		t.Skip("EFT_TEST_LICENSE_MODULE and EFT_TEST_LICENSE_SERIAL must be set for license registration acceptance tests")
+	}
+
+	resourceName := "globalscapeeft_node_license.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%s
+
+resource "globalscapeeft_node_license" "test" {
+  module        = %q
+  serial_number = %q
+}
+`, testAccProviderConfig(), module, serial),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "node_id"),
+					resource.TestCheckResourceAttr(resourceName, "registered", "true"),
+				),
+			},
+			{
+				ResourceName:  resourceName,
+				ImportState:   true,
+				ImportStateId: "node/" + module,
+				ExpectError:   regexp.MustCompile("Import not supported"),
+			},
+		},
+	})
+}
+
+func TestAccComplianceReportDataSources_basic(t *testing.T) {
+	testAccPreCheck(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+data "globalscapeeft_dpia_report" "current" {}
+data "globalscapeeft_pci_compliance_report" "current" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.globalscapeeft_dpia_report.current", "total_score"),
+					resource.TestCheckResourceAttrSet("data.globalscapeeft_dpia_report.current", "raw_json"),
+					resource.TestCheckResourceAttrSet("data.globalscapeeft_pci_compliance_report.current", "total_failed"),
+					resource.TestCheckResourceAttrSet("data.globalscapeeft_pci_compliance_report.current", "raw_json"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSiteTemplate_basic(t *testing.T) {
+	testAccPreCheck(t)
+	siteID := testAccSiteID(t)
+
+	resourceName := "globalscapeeft_site_template.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSiteTemplateConfig(siteID, "<p>Terraform acceptance test</p>"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", siteID+"/WorkspacesAdminAddedParticipantMsg.html"),
+					resource.TestCheckResourceAttr(resourceName, "content_sha256", templateHash("<p>Terraform acceptance test</p>")),
+				),
+			},
+			{
+				Config: testAccSiteTemplateConfig(siteID, "<p>Terraform acceptance test, updated</p>"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "content_sha256", templateHash("<p>Terraform acceptance test, updated</p>")),
+			},
+		},
+	})
+}
+
+func TestAccSiteDataSource_basic(t *testing.T) {
+	testAccPreCheck(t)
+	siteID := testAccSiteID(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + fmt.Sprintf(`
+data "globalscapeeft_site" "by_id" {
+  id = %q
+}
+
+data "globalscapeeft_site" "by_name" {
+  name = data.globalscapeeft_site.by_id.name
+}
+
+data "globalscapeeft_sites" "filtered" {
+  name = data.globalscapeeft_site.by_id.name
+}
+`, siteID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.globalscapeeft_site.by_id", "root_folder"),
+					resource.TestCheckResourceAttr("data.globalscapeeft_site.by_name", "id", siteID),
+					resource.TestCheckResourceAttr("data.globalscapeeft_sites.filtered", "sites.#", "1"),
+					resource.TestCheckResourceAttr("data.globalscapeeft_sites.filtered", "sites.0.id", siteID),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSite_basic(t *testing.T) {
+	testAccPreCheck(t)
+	rootFolder := os.Getenv("EFT_TEST_SITE_ROOT")
+	if rootFolder == "" {
+		t.Skip("EFT_TEST_SITE_ROOT must be set for site acceptance tests; the created site is kept on the server")
+	}
+
+	resourceName := "globalscapeeft_site.test"
+	name := fmt.Sprintf("tf-acc-%d", time.Now().Unix())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSiteConfig(name, rootFolder+`\`+name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "root_folder", rootFolder+`\`+name),
+					resource.TestCheckResourceAttrSet(resourceName, "auth_type"),
+				),
+			},
+			{
+				// A rename would replace the site and leave the old one behind.
+				Config:      testAccSiteConfig(name+"-renamed", rootFolder+`\`+name),
+				ExpectError: regexp.MustCompile("Site attribute cannot be changed"),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"root_folder", "pci_compliance_enabled", "create_unix_style_subfolders", "create_folders_for_new_users", "timeouts"},
+			},
+		},
+	})
+}
+
+func TestAccSiteAuthentication_basic(t *testing.T) {
+	testAccPreCheck(t)
+	siteID := testAccSiteID(t)
+
+	resourceName := "globalscapeeft_site_authentication.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + fmt.Sprintf(`
+resource "globalscapeeft_site_authentication" "test" {
+  site_id = %q
+  type    = "EFT"
+}
+`, siteID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", siteID),
+					resource.TestCheckResourceAttr(resourceName, "type", "EFT"),
+					resource.TestCheckNoResourceAttr(resourceName, "ad.domain"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSiteGeneral_basic(t *testing.T) {
+	testAccPreCheck(t)
+	siteID := testAccSiteID(t)
+
+	resourceName := "globalscapeeft_site_general.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + fmt.Sprintf(`
+resource "globalscapeeft_site_general" "test" {
+  site_id                  = %q
+  override_vfs_credentials = false
+}
+`, siteID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", siteID),
+					resource.TestCheckResourceAttr(resourceName, "override_vfs_credentials", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "root_folder"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSiteFTPS_basic(t *testing.T) {
+	testAccPreCheck(t)
+	siteID := testAccSiteID(t)
+
+	resourceName := "globalscapeeft_site_ftps.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + fmt.Sprintf(`
+resource "globalscapeeft_site_ftps" "test" {
+  site_id       = %q
+  ftp_enabled   = false
+  pasv_port_min = 28000
+  pasv_port_max = 30000
+}
+`, siteID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", siteID),
+					resource.TestCheckResourceAttr(resourceName, "ftp_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "pasv_port_min", "28000"),
+					resource.TestCheckResourceAttr(resourceName, "pasv_port_max", "30000"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProviderConfig() + fmt.Sprintf(`
+resource "globalscapeeft_site_ftps" "test" {
+  site_id       = %q
+  pasv_port_min = 30000
+  pasv_port_max = 28000
+}
+`, siteID),
+				ExpectError: regexp.MustCompile("Invalid PASV port range"),
+			},
+		},
+	})
+}
+
+func TestAccSiteHTTPS_basic(t *testing.T) {
+	testAccPreCheck(t)
+	siteID := testAccSiteID(t)
+
+	resourceName := "globalscapeeft_site_https.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + fmt.Sprintf(`
+resource "globalscapeeft_site_https" "test" {
+  site_id     = %q
+  enable_hsts = true
+}
+`, siteID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", siteID),
+					resource.TestCheckResourceAttr(resourceName, "enable_hsts", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "https_port"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProviderConfig() + fmt.Sprintf(`
+data "globalscapeeft_server" "current" {}
+
+resource "globalscapeeft_site_https" "test" {
+  site_id       = %q
+  https_enabled = true
+  https_port    = data.globalscapeeft_server.current.listener_settings.admin_port
+}
+`, siteID),
+				ExpectError: regexp.MustCompile("Port collides with the administration port"),
+			},
+		},
+	})
+}
+
+func TestAccSiteNetworkSecurity_basic(t *testing.T) {
+	testAccPreCheck(t)
+	siteID := testAccSiteID(t)
+
+	resourceName := "globalscapeeft_site_network_security.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + fmt.Sprintf(`
+resource "globalscapeeft_site_network_security" "test" {
+  site_id = %q
+
+  connection_limits = {
+    max_connections_per_ip = 10
+  }
+}
+`, siteID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", siteID),
+					resource.TestCheckResourceAttr(resourceName, "connection_limits.max_connections_per_ip", "10"),
+					resource.TestCheckResourceAttrSet(resourceName, "auto_ban.enabled"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSiteIPAccessRule_basic(t *testing.T) {
+	testAccPreCheck(t)
+	siteID := testAccSiteID(t)
+
+	resourceName := "globalscapeeft_site_ip_access_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + testAccSiteIPAccessRuleConfig(siteID, "terraform acceptance test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", siteID+"/198.51.100.77"),
+					resource.TestCheckResourceAttr(resourceName, "access_type", "Deny"),
+					resource.TestCheckResourceAttrSet(resourceName, "sequence_number"),
+				),
+			},
+			{
+				Config: testAccProviderConfig() + testAccSiteIPAccessRuleConfig(siteID, "terraform acceptance test updated"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "reason", "terraform acceptance test updated"),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSiteIPAccessList_basic(t *testing.T) {
+	testAccPreCheck(t)
+	siteID := testAccSiteID(t)
+	if os.Getenv("EFT_TEST_IP_ACCESS_LIST") == "" {
+		t.Skip("EFT_TEST_IP_ACCESS_LIST must be set; the test replaces the site IP access list")
+	}
+
+	resourceName := "globalscapeeft_site_ip_access_list.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + testAccSiteIPAccessListConfig(siteID, "198.51.100.10", "198.51.100.11"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "entry.0.address", "198.51.100.10"),
+				),
+			},
+			{
+				Config: testAccProviderConfig() + testAccSiteIPAccessListConfig(siteID, "198.51.100.11", "198.51.100.10"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "entry.0.address", "198.51.100.11"),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSiteIPAutoBansDataSource_basic(t *testing.T) {
+	testAccPreCheck(t)
+	siteID := testAccSiteID(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + fmt.Sprintf(`
+data "globalscapeeft_site_ip_auto_bans" "test" {
+  site_id = %q
+}
+`, siteID),
+				Check: resource.TestCheckResourceAttrSet("data.globalscapeeft_site_ip_auto_bans.test", "bans.#"),
+			},
+		},
+	})
+}
+
+func TestAccSiteSMSProfile_basic(t *testing.T) {
+	testAccPreCheck(t)
+	siteID := testAccSiteID(t)
+
+	resourceName := "globalscapeeft_site_sms_profile.test"
+	name := fmt.Sprintf("tf-acctest-%d", os.Getpid())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + testAccSiteSMSProfileConfig(siteID, name, "+12105550100"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", siteID+"/"+name),
+					resource.TestCheckResourceAttr(resourceName, "sender_number", "+12105550100"),
+					resource.TestCheckNoResourceAttr(resourceName, "auth_token"),
+				),
+			},
+			{
+				Config: testAccProviderConfig() + testAccSiteSMSProfileConfig(siteID, name, "+12105550101"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "sender_number", "+12105550101"),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"credentials_version"},
+			},
+		},
+	})
+}
+
+func TestAccSiteAccountSecurity_basic(t *testing.T) {
+	testAccPreCheck(t)
+	siteID := testAccSiteID(t)
+
+	resourceName := "globalscapeeft_site_account_security.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + fmt.Sprintf(`
+resource "globalscapeeft_site_account_security" "test" {
+  site_id = %q
+
+  invalid_login = {
+    account = {
+      attempt_retries = 5
+    }
+  }
+}
+`, siteID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", siteID),
+					resource.TestCheckResourceAttr(resourceName, "invalid_login.account.attempt_retries", "5"),
+					resource.TestCheckResourceAttrSet(resourceName, "inactivity.enabled"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSiteWorkspaces_basic(t *testing.T) {
+	testAccPreCheck(t)
+	siteID := testAccSiteID(t)
+
+	resourceName := "globalscapeeft_site_workspaces.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + fmt.Sprintf(`
+resource "globalscapeeft_site_workspaces" "test" {
+  site_id                = %q
+  denied_domains_enabled = true
+  denied_domains         = ["*.example.invalid"]
+}
+`, siteID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", siteID),
+					resource.TestCheckResourceAttr(resourceName, "denied_domains.0", "*.example.invalid"),
+					resource.TestCheckResourceAttrSet(resourceName, "enabled"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSiteSendPortal_basic(t *testing.T) {
+	testAccPreCheck(t)
+	siteID := testAccSiteID(t)
+
+	resourceName := "globalscapeeft_site_send_portal.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + fmt.Sprintf(`
+resource "globalscapeeft_site_send_portal" "test" {
+  site_id                  = %q
+  allow_replies_with_files = false
+}
+`, siteID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", siteID),
+					resource.TestCheckResourceAttr(resourceName, "allow_replies_with_files", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "enabled"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSiteDropOffPortal_basic(t *testing.T) {
+	testAccPreCheck(t)
+	siteID := testAccSiteID(t)
+
+	resourceName := "globalscapeeft_site_drop_off_portal.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + fmt.Sprintf(`
+resource "globalscapeeft_site_drop_off_portal" "test" {
+  site_id      = %q
+  address_book = ["tf-acctest@example.invalid"]
+}
+`, siteID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", siteID),
+					resource.TestCheckResourceAttr(resourceName, "address_book.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSitePrivacyPolicy_basic(t *testing.T) {
+	testAccPreCheck(t)
+	siteID := testAccSiteID(t)
+
+	resourceName := "globalscapeeft_site_privacy_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + fmt.Sprintf(`
+resource "globalscapeeft_site_privacy_policy" "test" {
+  site_id                  = %q
+  implied_for_non_subjects = true
+}
+`, siteID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", siteID),
+					resource.TestCheckResourceAttr(resourceName, "implied_for_non_subjects", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "type"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSiteTermsOfService_basic(t *testing.T) {
+	testAccPreCheck(t)
+	siteID := testAccSiteID(t)
+
+	resourceName := "globalscapeeft_site_terms_of_service.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + fmt.Sprintf(`
+resource "globalscapeeft_site_terms_of_service" "test" {
+  site_id                    = %q
+  prevent_sftps_until_agreed = false
+}
+`, siteID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", siteID),
+					resource.TestCheckResourceAttr(resourceName, "prevent_sftps_until_agreed", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSiteGDPR_basic(t *testing.T) {
+	testAccPreCheck(t)
+	siteID := testAccSiteID(t)
+
+	resourceName := "globalscapeeft_site_gdpr.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + fmt.Sprintf(`
+resource "globalscapeeft_site_gdpr" "test" {
+  site_id        = %q
+  material_scope = "In scope"
+  show_eu_status = true
+}
+`, siteID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", siteID),
+					resource.TestCheckResourceAttr(resourceName, "material_scope", "In scope"),
+					resource.TestCheckResourceAttr(resourceName, "show_eu_status", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSiteUploadForm_basic(t *testing.T) {
+	testAccPreCheck(t)
+	siteID := testAccSiteID(t)
+
+	resourceName := "globalscapeeft_site_upload_form.test"
+	name := fmt.Sprintf("tf-acctest-%d", os.Getpid())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + testAccSiteUploadFormConfig(siteID, name, "Case intake"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", siteID+"/"+name),
+					resource.TestCheckResourceAttr(resourceName, "field.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "field.1.options.#", "2"),
+				),
+			},
+			{
+				Config: testAccProviderConfig() + testAccSiteUploadFormConfig(siteID, name, "Case intake (updated)"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "display_name", "Case intake (updated)"),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSiteContentIntegrityProfile_basic(t *testing.T) {
+	testAccPreCheck(t)
+	siteID := testAccSiteID(t)
+
+	resourceName := "globalscapeeft_site_content_integrity_profile.test"
+	name := fmt.Sprintf("tf-acctest-%d", os.Getpid())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + testAccSiteContentIntegrityProfileConfig(siteID, name, "Continue"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "profile_id"),
+					resource.TestCheckResourceAttr(resourceName, "host", "icap.example.invalid"),
+				),
+			},
+			{
+				Config: testAccProviderConfig() + testAccSiteContentIntegrityProfileConfig(siteID, name, "Fail"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "on_connectivity_error", "Fail"),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Some EFT builds mask host and path; see the import docs.
+				ImportStateVerifyIgnore: []string{"host", "path"},
+			},
+		},
+	})
+}
+
+func TestAccSiteCustomCommand_basic(t *testing.T) {
+	testAccPreCheck(t)
+	siteID := testAccSiteID(t)
+
+	resourceName := "globalscapeeft_site_custom_command.test"
+	name := fmt.Sprintf("TFACC%d", os.Getpid())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + testAccSiteCustomCommandConfig(siteID, name, 60),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "command_id"),
+					resource.TestCheckResourceAttr(resourceName, "timeout_seconds", "60"),
+					resource.TestCheckResourceAttr(resourceName, "min_parameters", "1"),
+				),
+			},
+			{
+				Config: testAccProviderConfig() + testAccSiteCustomCommandConfig(siteID, name, 120),
+				Check:  resource.TestCheckResourceAttr(resourceName, "timeout_seconds", "120"),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSiteUserTemplate_basic(t *testing.T) {
+	testAccPreCheck(t)
+	siteID := testAccSiteID(t)
+
+	resourceName := "globalscapeeft_site_user_template.test"
+	name := fmt.Sprintf("tf-acctest-%d", os.Getpid())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + testAccSiteUserTemplateConfig(siteID, name, 600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "template_id"),
+					resource.TestCheckResourceAttr(resourceName, "ftp.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "connection_limits.timeout_seconds", "600"),
+				),
+			},
+			{
+				Config: testAccProviderConfig() + testAccSiteUserTemplateConfig(siteID, name, 0),
+				Check:  resource.TestCheckResourceAttr(resourceName, "connection_limits.timeout_seconds", "0"),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Only the settings present in the configuration are managed,
+				// so an import reads none of them.
+				ImportStateVerifyIgnore: []string{"settings_json", "ftp", "connection_limits"},
+			},
+		},
+	})
+}
+
 func TestAccSiteUser_basic(t *testing.T) {
 	testAccPreCheck(t)
 	siteID := os.Getenv("EFT_TEST_SITE_ID")
@@ -78,6 +1034,15 @@ func testAccPreCheck(t *testing.T) {
 	}
 }
 
+// testAccSiteID returns the site that site-scoped acceptance tests may modify.
+func testAccSiteID(t *testing.T) string {
+	siteID := os.Getenv("EFT_TEST_SITE_ID")
+	if siteID == "" {
+		t.Skip("EFT_TEST_SITE_ID must be set for site acceptance tests")
+	}
+	return siteID
+}
+
 func testAccProviderConfig() string {
 	authType := os.Getenv("EFT_TEST_AUTHTYPE")
 	if authType == "" {
@@ -115,6 +1080,160 @@ resource "globalscapeeft_site_user" "test" {
 `, testAccProviderConfig(), siteID, loginName, displayName, email)
 }
 
+func testAccHAUpgradeStateConfig(desired string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "globalscapeeft_ha_upgrade_state" "test" {
+  desired_state = %q
+}
+`, testAccProviderConfig(), desired)
+}
+
+func testAccServerSettingsConfig(enableUtc bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "globalscapeeft_server_settings" "test" {
+  enable_utc_in_listings = %t
+}
+`, testAccProviderConfig(), enableUtc)
+}
+
+func testAccSiteTemplateConfig(siteID, content string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "globalscapeeft_site_template" "test" {
+  site_id       = %q
+  template_name = "WorkspacesAdminAddedParticipantMsg.html"
+  content       = %q
+}
+`, testAccProviderConfig(), siteID, content)
+}
+
+func testAccSiteConfig(name, rootFolder string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "globalscapeeft_site" "test" {
+  name        = %q
+  root_folder = %q
+}
+`, name, rootFolder)
+}
+
+func testAccSiteIPAccessRuleConfig(siteID, reason string) string {
+	return fmt.Sprintf(`
+resource "globalscapeeft_site_ip_access_rule" "test" {
+  site_id     = %q
+  address     = "198.51.100.77"
+  access_type = "Deny"
+  reason      = %q
+}
+`, siteID, reason)
+}
+
+func testAccSiteIPAccessListConfig(siteID, first, second string) string {
+	return fmt.Sprintf(`
+resource "globalscapeeft_site_ip_access_list" "test" {
+  site_id = %q
+
+  entry {
+    address     = %q
+    access_type = "Deny"
+  }
+
+  entry {
+    address     = %q
+    access_type = "Allow"
+  }
+}
+`, siteID, first, second)
+}
+
+func testAccSiteSMSProfileConfig(siteID, name, sender string) string {
+	return fmt.Sprintf(`
+resource "globalscapeeft_site_sms_profile" "test" {
+  site_id             = %q
+  name                = %q
+  account_sid         = "AC0123456789abcdef0123456789abcdef"
+  auth_token          = "acceptance-test-token"
+  credentials_version = 1
+  sender_number       = %q
+}
+`, siteID, name, sender)
+}
+
+func testAccSiteUploadFormConfig(siteID, name, displayName string) string {
+	return fmt.Sprintf(`
+resource "globalscapeeft_site_upload_form" "test" {
+  site_id      = %q
+  name         = %q
+  display_name = %q
+
+  field {
+    name     = "case_number"
+    label    = "Case number"
+    type     = "String"
+    required = true
+  }
+
+  field {
+    name    = "department"
+    type    = "String"
+    options = ["Legal", "HR"]
+  }
+}
+`, siteID, name, displayName)
+}
+
+func testAccSiteContentIntegrityProfileConfig(siteID, name, onConnectivityError string) string {
+	return fmt.Sprintf(`
+resource "globalscapeeft_site_content_integrity_profile" "test" {
+  site_id               = %q
+  name                  = %q
+  host                  = "icap.example.invalid"
+  path                  = "/avscan"
+  on_connectivity_error = %q
+}
+`, siteID, name, onConnectivityError)
+}
+
+func testAccSiteCustomCommandConfig(siteID, name string, timeout int) string {
+	return fmt.Sprintf(`
+resource "globalscapeeft_site_custom_command" "test" {
+  site_id         = %q
+  name            = %q
+  executable      = "c:\\windows\\system32\\cmd.exe"
+  parameters      = "/c echo %%1"
+  timeout_seconds = %d
+
+  min_parameters         = 1
+  min_parameters_message = "Usage: SITE %s <text>"
+}
+`, siteID, name, timeout, name)
+}
+
+func testAccSiteUserTemplateConfig(siteID, name string, timeoutSeconds int) string {
+	return fmt.Sprintf(`
+resource "globalscapeeft_site_user_template" "test" {
+  site_id = %q
+  name    = %q
+
+  ftp {
+    enabled = true
+  }
+
+  connection_limits {
+    timeout_seconds = %d
+  }
+
+  settings_json = jsonencode({
+    sftp = { enabled = true }
+  })
+}
+`, siteID, name, timeoutSeconds)
+}
+
 func testAccClient() (*client.Client, error) {
 	authType := os.Getenv("EFT_TEST_AUTHTYPE")
 	if authType == "" {
@@ -159,3 +1278,22 @@ func testAccCheckSiteUserDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+// testAccCheckServerSMTPUnchanged verifies the partial PATCH sent by
+// globalscapeeft_server_settings left the SMTP settings alone.
+func testAccCheckServerSMTPUnchanged(want client.SMTPSettings) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		c, err := testAccClient()
+		if err != nil {
+			return err
+		}
+		server, err := c.GetServer(context.Background())
+		if err != nil {
+			return err
+		}
+		if got := server.Attributes.SMTP; got != want {
+			return fmt.Errorf("smtp settings changed: got %+v, want %+v", got, want)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &serverMetricsDataSource{}
+
+func NewServerMetricsDataSource() datasource.DataSource {
+	return &serverMetricsDataSource{}
+}
+
+type serverMetricsDataSource struct {
+	client *client.Client
+}
+
+type serverMetricsDataSourceModel struct {
+	ID types.String `tfsdk:"id"`
+	activityModel
+}
+
+// activityModel mirrors client.Activity and is shared by the status and
+// metrics data sources.
+type activityModel struct {
+	ActiveClientDownloadBytesPerSecond types.Int64 `tfsdk:"active_client_download_bytes_per_second"`
+	ActiveClientDownloadCount          types.Int64 `tfsdk:"active_client_download_count"`
+	ActiveClientUploadBytesPerSecond   types.Int64 `tfsdk:"active_client_upload_bytes_per_second"`
+	ActiveClientUploadCount            types.Int64 `tfsdk:"active_client_upload_count"`
+	ActiveServerDownloadBytesPerSecond types.Int64 `tfsdk:"active_server_download_bytes_per_second"`
+	ActiveServerDownloadCount          types.Int64 `tfsdk:"active_server_download_count"`
+	ActiveServerUploadBytesPerSecond   types.Int64 `tfsdk:"active_server_upload_bytes_per_second"`
+	ActiveServerUploadCount            types.Int64 `tfsdk:"active_server_upload_count"`
+	ActiveTransferCount                types.Int64 `tfsdk:"active_transfer_count"`
+	ConnectedAdminCount                types.Int64 `tfsdk:"connected_admin_count"`
+	ConnectedUserCount                 types.Int64 `tfsdk:"connected_user_count"`
+	RunningAwTaskCount                 types.Int64 `tfsdk:"running_aw_task_count"`
+	RunningEventRuleCount              types.Int64 `tfsdk:"running_event_rule_count"`
+}
+
+func activitySchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"active_client_download_bytes_per_second": schema.Int64Attribute{Computed: true},
+		"active_client_download_count":            schema.Int64Attribute{Computed: true},
+		"active_client_upload_bytes_per_second":   schema.Int64Attribute{Computed: true},
+		"active_client_upload_count":              schema.Int64Attribute{Computed: true},
+		"active_server_download_bytes_per_second": schema.Int64Attribute{Computed: true},
+		"active_server_download_count":            schema.Int64Attribute{Computed: true},
+		"active_server_upload_bytes_per_second":   schema.Int64Attribute{Computed: true},
+		"active_server_upload_count":              schema.Int64Attribute{Computed: true},
+		"active_transfer_count": schema.Int64Attribute{
+			MarkdownDescription: "Sum of active client and server uploads and downloads.",
+			Computed:            true,
+		},
+		"connected_admin_count":    schema.Int64Attribute{Computed: true},
+		"connected_user_count":     schema.Int64Attribute{Computed: true},
+		"running_aw_task_count":    schema.Int64Attribute{Computed: true},
+		"running_event_rule_count": schema.Int64Attribute{Computed: true},
+	}
+}
+
+func newActivityModel(a client.Activity) activityModel {
+	return activityModel{
+		ActiveClientDownloadBytesPerSecond: types.Int64Value(a.ActiveClientDownloadBytesPerSecond),
+		ActiveClientDownloadCount:          types.Int64Value(a.ActiveClientDownloadCount),
+		ActiveClientUploadBytesPerSecond:   types.Int64Value(a.ActiveClientUploadBytesPerSecond),
+		ActiveClientUploadCount:            types.Int64Value(a.ActiveClientUploadCount),
+		ActiveServerDownloadBytesPerSecond: types.Int64Value(a.ActiveServerDownloadBytesPerSecond),
+		ActiveServerDownloadCount:          types.Int64Value(a.ActiveServerDownloadCount),
+		ActiveServerUploadBytesPerSecond:   types.Int64Value(a.ActiveServerUploadBytesPerSecond),
+		ActiveServerUploadCount:            types.Int64Value(a.ActiveServerUploadCount),
+		ActiveTransferCount:                types.Int64Value(a.ActiveTransferCount()),
+		ConnectedAdminCount:                types.Int64Value(a.ConnectedAdminCount),
+		ConnectedUserCount:                 types.Int64Value(a.ConnectedUserCount),
+		RunningAwTaskCount:                 types.Int64Value(a.RunningAwTaskCount),
+		RunningEventRuleCount:              types.Int64Value(a.RunningEventRuleCount),
+	}
+}
+
+func (d *serverMetricsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_metrics"
+}
+
+func (d *serverMetricsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := activitySchemaAttributes()
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "Server identifier provided by the API.",
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetch current Globalscape EFT server transfer and session metrics.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *serverMetricsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if c, ok := req.ProviderData.(*client.Client); ok {
+		d.client = c
+	}
+}
+
+func (d *serverMetricsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	metrics, err := d.client.GetServerMetrics(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to query server metrics", err.Error())
+		return
+	}
+
+	data := serverMetricsDataSourceModel{
+		ID:            types.StringValue(metrics.ID),
+		activityModel: newActivityModel(metrics.Attributes),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,249 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &serverSettingsResource{}
+var _ resource.ResourceWithConfigure = &serverSettingsResource{}
+var _ resource.ResourceWithImportState = &serverSettingsResource{}
+
+func NewServerSettingsResource() resource.Resource {
+	return &serverSettingsResource{}
+}
+
+type serverSettingsResource struct {
+	client *client.Client
+}
+
+type serverSettingsResourceModel struct {
+	ID                         types.String `tfsdk:"id"`
+	ListenIPs                  types.List   `tfsdk:"listen_ips"`
+	AdminPort                  types.Int64  `tfsdk:"admin_port"`
+	EnableRemoteAdministration types.Bool   `tfsdk:"enable_remote_administration"`
+	EnableUtcInListings        types.Bool   `tfsdk:"enable_utc_in_listings"`
+}
+
+func (r *serverSettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_settings"
+}
+
+func (r *serverSettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages Globalscape EFT server general and administrative listener settings. Only the configured attributes are sent to the API, so this resource can be used alongside `globalscapeeft_server_smtp`. Destroying this resource will only remove it from Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Server identifier.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"listen_ips": schema.ListAttribute{
+				MarkdownDescription: "IP addresses the administrative listener binds to (for example `0.0.0.0`).",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"admin_port": schema.Int64Attribute{
+				MarkdownDescription: "Port used by the administration interface and REST API.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"enable_remote_administration": schema.BoolAttribute{
+				MarkdownDescription: "Whether remote administration connections are accepted.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enable_utc_in_listings": schema.BoolAttribute{
+				MarkdownDescription: "Whether file listings report timestamps in UTC.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *serverSettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if c, ok := req.ProviderData.(*client.Client); ok {
+		r.client = c
+	}
+}
+
+func (r *serverSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var plan serverSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	general, listener, diags := plan.toAPIModel(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	server, err := r.client.UpdateServerSettings(ctx, general, listener)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update server settings", err.Error())
+		return
+	}
+
+	newState, diags := fromServerToSettingsModel(ctx, server)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	newState.ID = types.StringValue("1")
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *serverSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var state serverSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	server, err := r.client.GetServer(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read server", err.Error())
+		return
+	}
+
+	newState, diags := fromServerToSettingsModel(ctx, server)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	newState.ID = state.ID
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *serverSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var plan serverSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	general, listener, diags := plan.toAPIModel(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	server, err := r.client.UpdateServerSettings(ctx, general, listener)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update server settings", err.Error())
+		return
+	}
+
+	newState, diags := fromServerToSettingsModel(ctx, server)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	newState.ID = plan.ID
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *serverSettingsResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Server settings cannot be deleted via the API. Removing the resource from
+	// Terraform state only; the configuration remains on the EFT server.
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *serverSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// toAPIModel only populates the fields that are known in the plan so the PATCH
+// body stays partial.
+func (m *serverSettingsResourceModel) toAPIModel(ctx context.Context) (client.ServerGeneralUpdate, client.ListenerSettingsUpdate, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	general := client.ServerGeneralUpdate{}
+	listener := client.ListenerSettingsUpdate{}
+
+	if !m.EnableUtcInListings.IsNull() && !m.EnableUtcInListings.IsUnknown() {
+		v := m.EnableUtcInListings.ValueBool()
+		general.EnableUtcInListings = &v
+	}
+	if !m.AdminPort.IsNull() && !m.AdminPort.IsUnknown() {
+		v := m.AdminPort.ValueInt64()
+		listener.AdminPort = &v
+	}
+	if !m.EnableRemoteAdministration.IsNull() && !m.EnableRemoteAdministration.IsUnknown() {
+		v := m.EnableRemoteAdministration.ValueBool()
+		listener.EnableRemoteAdministration = &v
+	}
+	if !m.ListenIPs.IsNull() && !m.ListenIPs.IsUnknown() {
+		diags.Append(m.ListenIPs.ElementsAs(ctx, &listener.ListenIPs, false)...)
+	}
+
+	return general, listener, diags
+}
+
+func fromServerToSettingsModel(ctx context.Context, server *client.Server) (*serverSettingsResourceModel, diag.Diagnostics) {
+	listenIPs, diags := types.ListValueFrom(ctx, types.StringType, server.Attributes.ListenerSettings.ListenIPs)
+
+	return &serverSettingsResourceModel{
+		ID:                         types.StringValue(server.ID),
+		ListenIPs:                  listenIPs,
+		AdminPort:                  types.Int64Value(server.Attributes.ListenerSettings.AdminPort),
+		EnableRemoteAdministration: types.BoolValue(server.Attributes.ListenerSettings.EnableRemoteAdministration),
+		EnableUtcInListings:        types.BoolValue(server.Attributes.General.EnableUtcInListings),
+	}, diags
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestServerSettingsToAPIModel(t *testing.T) {
+	ctx := context.Background()
+	unset := serverSettingsResourceModel{
+		ListenIPs:                  types.ListNull(types.StringType),
+		AdminPort:                  types.Int64Null(),
+		EnableRemoteAdministration: types.BoolUnknown(),
+		EnableUtcInListings:        types.BoolNull(),
+	}
+
+	tests := []struct {
+		name         string
+		setup        func(m *serverSettingsResourceModel)
+		wantGeneral  client.ServerGeneralUpdate
+		wantListener client.ListenerSettingsUpdate
+	}{
+		{name: "nothing set"},
+		{
+			name:        "utc only",
+			setup:       func(m *serverSettingsResourceModel) { m.EnableUtcInListings = types.BoolValue(false) },
+			wantGeneral: client.ServerGeneralUpdate{EnableUtcInListings: boolPointer(types.BoolValue(false))},
+		},
+		{
+			name: "listener only",
+			setup: func(m *serverSettingsResourceModel) {
+				m.AdminPort = types.Int64Value(1100)
+				m.ListenIPs = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("0.0.0.0")})
+			},
+			wantListener: client.ListenerSettingsUpdate{AdminPort: int64Pointer(types.Int64Value(1100)), ListenIPs: []string{"0.0.0.0"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := unset
+			if tt.setup != nil {
+				tt.setup(&m)
+			}
+			general, listener, diags := m.toAPIModel(ctx)
+			if diags.HasError() {
+				t.Fatalf("toAPIModel() diagnostics: %v", diags)
+			}
+			if !reflect.DeepEqual(general, tt.wantGeneral) {
+				t.Errorf("general = %+v, want %+v", general, tt.wantGeneral)
+			}
+			if !reflect.DeepEqual(listener, tt.wantListener) {
+				t.Errorf("listener = %+v, want %+v", listener, tt.wantListener)
+			}
+		})
+	}
+}
+
+func TestFromServerToSettingsModel(t *testing.T) {
+	server := &client.Server{ID: "server-1"}
+	server.Attributes.General.EnableUtcInListings = true
+	server.Attributes.ListenerSettings.AdminPort = 1100
+	server.Attributes.ListenerSettings.ListenIPs = []string{"10.0.0.5"}
+
+	m, diags := fromServerToSettingsModel(context.Background(), server)
+	if diags.HasError() {
+		t.Fatalf("fromServerToSettingsModel() diagnostics: %v", diags)
+	}
+
+	want := &serverSettingsResourceModel{
+		ID:                         types.StringValue("server-1"),
+		ListenIPs:                  types.ListValueMust(types.StringType, []attr.Value{types.StringValue("10.0.0.5")}),
+		AdminPort:                  types.Int64Value(1100),
+		EnableRemoteAdministration: types.BoolValue(false),
+		EnableUtcInListings:        types.BoolValue(true),
+	}
+	if !reflect.DeepEqual(m, want) {
+		t.Errorf("fromServerToSettingsModel() = %+v, want %+v", m, want)
+	}
+}
@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &serverStatusDataSource{}
+
+func NewServerStatusDataSource() datasource.DataSource {
+	return &serverStatusDataSource{}
+}
+
+type serverStatusDataSource struct {
+	client *client.Client
+}
+
+type serverStatusDataSourceModel struct {
+	ActiveTransferCount types.Int64             `tfsdk:"active_transfer_count"`
+	AllNodesStarted     types.Bool              `tfsdk:"all_nodes_started"`
+	Nodes               []serverStatusNodeModel `tfsdk:"nodes"`
+}
+
+type serverStatusNodeModel struct {
+	IsInCluster types.Bool           `tfsdk:"is_in_cluster"`
+	IsMaster    types.Bool           `tfsdk:"is_master"`
+	IsStarted   types.Bool           `tfsdk:"is_started"`
+	Activity    activityModel        `tfsdk:"activity"`
+	Licenses    []licenseStatusModel `tfsdk:"licenses"`
+}
+
+type licenseStatusModel struct {
+	Module            types.String `tfsdk:"module"`
+	RegistrationState types.String `tfsdk:"registration_state"`
+}
+
+func (d *serverStatusDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_status"
+}
+
+func (d *serverStatusDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetch the runtime status of every Globalscape EFT node, including activity counters and license registration state.",
+		Attributes: map[string]schema.Attribute{
+			"active_transfer_count": schema.Int64Attribute{
+				MarkdownDescription: "Sum of active transfers across all nodes.",
+				Computed:            true,
+			},
+			"all_nodes_started": schema.BoolAttribute{
+				MarkdownDescription: "True when every reported node is started.",
+				Computed:            true,
+			},
+			"nodes": schema.ListNestedAttribute{
+				MarkdownDescription: "Status reported for each node.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"is_in_cluster": schema.BoolAttribute{Computed: true},
+						"is_master":     schema.BoolAttribute{Computed: true},
+						"is_started":    schema.BoolAttribute{Computed: true},
+						"activity": schema.SingleNestedAttribute{
+							MarkdownDescription: "Transfer and session counters for the node.",
+							Computed:            true,
+							Attributes:          activitySchemaAttributes(),
+						},
+						"licenses": schema.ListNestedAttribute{
+							MarkdownDescription: "Registration state of each licensed module.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"module":             schema.StringAttribute{Computed: true},
+									"registration_state": schema.StringAttribute{Computed: true},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *serverStatusDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if c, ok := req.ProviderData.(*client.Client); ok {
+		d.client = c
+	}
+}
+
+func (d *serverStatusDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	status, err := d.client.GetServerStatus(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to query server status", err.Error())
+		return
+	}
+
+	data := newServerStatusModel(status)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// newServerStatusModel maps every node and aggregates the cluster-wide
+// counters. all_nodes_started is false when no nodes are reported.
+func newServerStatusModel(status *client.ServerStatus) serverStatusDataSourceModel {
+	var data serverStatusDataSourceModel
+	var activeTransfers int64
+	allStarted := true
+
+	for _, n := range status.Nodes {
+		node := serverStatusNodeModel{
+			IsInCluster: types.BoolValue(n.IsInCluster),
+			IsMaster:    types.BoolValue(n.IsMaster),
+			IsStarted:   types.BoolValue(n.IsStarted),
+			Activity:    newActivityModel(n.Activity),
+			Licenses:    []licenseStatusModel{},
+		}
+		for _, l := range n.Licenses {
+			node.Licenses = append(node.Licenses, licenseStatusModel{
+				Module:            types.StringValue(l.Module),
+				RegistrationState: types.StringValue(l.RegistrationState),
+			})
+		}
+
+		activeTransfers += n.Activity.ActiveTransferCount()
+		allStarted = allStarted && n.IsStarted
+		data.Nodes = append(data.Nodes, node)
+	}
+
+	data.ActiveTransferCount = types.Int64Value(activeTransfers)
+	data.AllNodesStarted = types.BoolValue(allStarted && len(status.Nodes) > 0)
+
+	return data
+}
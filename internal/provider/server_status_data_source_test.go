@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNewServerStatusModel(t *testing.T) {
+	busy := client.Activity{ActiveClientDownloadCount: 2, ActiveServerUploadCount: 1}
+	idle := client.Activity{ConnectedUserCount: 4}
+
+	tests := []struct {
+		name            string
+		nodes           []client.NodeStatus
+		wantAllStarted  bool
+		wantTransfers   int64
+		wantNodeCount   int
+		wantFirstActive int64
+	}{
+		{name: "no nodes", wantAllStarted: false},
+		{
+			name:            "single started node",
+			nodes:           []client.NodeStatus{{IsStarted: true, Activity: busy}},
+			wantAllStarted:  true,
+			wantTransfers:   3,
+			wantNodeCount:   1,
+			wantFirstActive: 3,
+		},
+		{
+			name:            "all started",
+			nodes:           []client.NodeStatus{{IsStarted: true, Activity: busy}, {IsStarted: true, Activity: busy}},
+			wantAllStarted:  true,
+			wantTransfers:   6,
+			wantNodeCount:   2,
+			wantFirstActive: 3,
+		},
+		{
+			name:            "one node stopped",
+			nodes:           []client.NodeStatus{{IsStarted: true, Activity: idle}, {IsStarted: false, Activity: busy}},
+			wantAllStarted:  false,
+			wantTransfers:   3,
+			wantNodeCount:   2,
+			wantFirstActive: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := newServerStatusModel(&client.ServerStatus{Nodes: tt.nodes})
+			if !got.AllNodesStarted.Equal(types.BoolValue(tt.wantAllStarted)) {
+				t.Errorf("all_nodes_started = %s, want %t", got.AllNodesStarted, tt.wantAllStarted)
+			}
+			if !got.ActiveTransferCount.Equal(types.Int64Value(tt.wantTransfers)) {
+				t.Errorf("active_transfer_count = %s, want %d", got.ActiveTransferCount, tt.wantTransfers)
+			}
+			if len(got.Nodes) != tt.wantNodeCount {
+				t.Fatalf("got %d nodes, want %d", len(got.Nodes), tt.wantNodeCount)
+			}
+			if tt.wantNodeCount > 0 && !got.Nodes[0].Activity.ActiveTransferCount.Equal(types.Int64Value(tt.wantFirstActive)) {
+				t.Errorf("nodes[0].activity.active_transfer_count = %s, want %d", got.Nodes[0].Activity.ActiveTransferCount, tt.wantFirstActive)
+			}
+		})
+	}
+}
+
+func TestNewServerStatusModelLicenses(t *testing.T) {
+	got := newServerStatusModel(&client.ServerStatus{Nodes: []client.NodeStatus{
+		{IsStarted: true, Licenses: []client.LicenseStatus{{Module: "SFTP", RegistrationState: "registered"}}},
+		{IsStarted: true},
+	}})
+
+	if l := got.Nodes[0].Licenses; len(l) != 1 || l[0].Module.ValueString() != "SFTP" || l[0].RegistrationState.ValueString() != "registered" {
+		t.Errorf("nodes[0].licenses = %+v", l)
+	}
+	// An empty list rather than nil keeps the attribute known in state.
+	if l := got.Nodes[1].Licenses; l == nil || len(l) != 0 {
+		t.Errorf("nodes[1].licenses = %#v, want empty list", l)
+	}
+}
@@ -0,0 +1,247 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"strings"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &serverTemplateResource{}
+var _ resource.ResourceWithConfigure = &serverTemplateResource{}
+var _ resource.ResourceWithImportState = &serverTemplateResource{}
+var _ resource.ResourceWithModifyPlan = &serverTemplateResource{}
+
+func NewServerTemplateResource() resource.Resource {
+	return &serverTemplateResource{}
+}
+
+type serverTemplateResource struct {
+	client *client.Client
+}
+
+type serverTemplateResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	TemplateName  types.String `tfsdk:"template_name"`
+	Source        types.String `tfsdk:"source"`
+	Content       types.String `tfsdk:"content"`
+	ContentSHA256 types.String `tfsdk:"content_sha256"`
+}
+
+func (r *serverTemplateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_template"
+}
+
+func (r *serverTemplateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := templateContentAttributes()
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "Template name.",
+		Computed:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["template_name"] = schema.StringAttribute{
+		MarkdownDescription: "Template file name, for example `WorkspacesAdminAddedParticipantMsg.html`.",
+		Required:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the content of a Globalscape EFT server notification template through the experimental templates API. Templates cannot be deleted, so destroying this resource only removes it from Terraform state.",
+		Attributes:          attributes,
+	}
+}
+
+func (r *serverTemplateResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if c, ok := req.ProviderData.(*client.Client); ok {
+		r.client = c
+	}
+}
+
+func (r *serverTemplateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan serverTemplateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hash, diags := plannedTemplateHash(plan.Source, plan.Content)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_sha256"), hash)...)
+}
+
+func (r *serverTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var plan serverTemplateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	content, err := readTemplateContent(plan.Source, plan.Content)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read template content", err.Error())
+		return
+	}
+
+	if err := r.client.UpdateServerTemplate(ctx, plan.TemplateName.ValueString(), content); err != nil {
+		resp.Diagnostics.AddError("Failed to update server template", err.Error())
+		return
+	}
+
+	plan.ID = plan.TemplateName
+	plan.ContentSHA256 = types.StringValue(templateHash(content))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *serverTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var state serverTemplateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.TemplateName.IsNull() {
+		state.TemplateName = state.ID
+	}
+
+	content, err := r.client.GetServerTemplate(ctx, state.TemplateName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read server template", err.Error())
+		return
+	}
+
+	// Only the hash is refreshed; a changed template on the server surfaces
+	// as a diff against the hash of the configured content.
+	state.ContentSHA256 = types.StringValue(templateHash(content))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *serverTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var plan serverTemplateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	content, err := readTemplateContent(plan.Source, plan.Content)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read template content", err.Error())
+		return
+	}
+
+	if err := r.client.UpdateServerTemplate(ctx, plan.TemplateName.ValueString(), content); err != nil {
+		resp.Diagnostics.AddError("Failed to update server template", err.Error())
+		return
+	}
+
+	plan.ContentSHA256 = types.StringValue(templateHash(content))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *serverTemplateResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Templates cannot be deleted via the API. Removing the resource from
+	// Terraform state only; the last applied content remains on the server.
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *serverTemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("template_name"), req.ID)...)
+}
+
+// templateContentAttributes returns the content attributes shared by the
+// server and site template resources.
+func templateContentAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"source": schema.StringAttribute{
+			MarkdownDescription: "Path to a local file holding the template content. Exactly one of `source` or `content` must be set.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.ExactlyOneOf(path.MatchRoot("content")),
+			},
+		},
+		"content": schema.StringAttribute{
+			MarkdownDescription: "Inline template content. Exactly one of `source` or `content` must be set.",
+			Optional:            true,
+		},
+		"content_sha256": schema.StringAttribute{
+			MarkdownDescription: "SHA-256 of the template content, used to detect changes made outside Terraform.",
+			Computed:            true,
+		},
+	}
+}
+
+func readTemplateContent(source, content types.String) (string, error) {
+	if v := stringValueOrEmpty(source); v != "" {
+		b, err := os.ReadFile(v)
+		if err != nil {
+			return "", err
+		}
+		return string(b), nil
+	}
+	return content.ValueString(), nil
+}
+
+// plannedTemplateHash hashes the configured content, leaving the value
+// unknown while the source path or content is not yet known.
+func plannedTemplateHash(source, content types.String) (types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if source.IsUnknown() || content.IsUnknown() {
+		return types.StringUnknown(), diags
+	}
+
+	v, err := readTemplateContent(source, content)
+	if err != nil {
+		diags.AddAttributeError(path.Root("source"), "Failed to read template content", err.Error())
+		return types.StringUnknown(), diags
+	}
+	return types.StringValue(templateHash(v)), diags
+}
+
+// templateHash normalizes line endings before hashing because EFT stores
+// templates with CRLF line endings regardless of what was uploaded.
+func templateHash(content string) string {
+	sum := sha256.Sum256([]byte(strings.ReplaceAll(content, "\r\n", "\n")))
+	return hex.EncodeToString(sum[:])
+}
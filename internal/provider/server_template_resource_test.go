@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTemplateHash(t *testing.T) {
+	tests := []struct {
+		name      string
+		a, b      string
+		wantEqual bool
+	}{
+		{name: "CRLF and LF are equivalent", a: "<p>Hello</p>\r\n<p>World</p>\r\n", b: "<p>Hello</p>\n<p>World</p>\n", wantEqual: true},
+		{name: "identical", a: "x", b: "x", wantEqual: true},
+		{name: "content differs", a: "<p>Hello</p>\n", b: "<p>Hullo</p>\n"},
+		{name: "trailing newline matters", a: "x\n", b: "x"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := templateHash(tt.a) == templateHash(tt.b); got != tt.wantEqual {
+				t.Fatalf("hashes equal = %v, want %v", got, tt.wantEqual)
+			}
+		})
+	}
+}
+
+func TestPlannedTemplateHash(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "template.html")
+	if err := os.WriteFile(file, []byte("<p>From file</p>\r\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		source      types.String
+		content     types.String
+		want        types.String
+		wantErr     bool
+		wantUnknown bool
+	}{
+		{name: "inline content", source: types.StringNull(), content: types.StringValue("<p>Inline</p>"), want: types.StringValue(templateHash("<p>Inline</p>"))},
+		{name: "source file", source: types.StringValue(file), content: types.StringNull(), want: types.StringValue(templateHash("<p>From file</p>\n"))},
+		{name: "unknown content", source: types.StringNull(), content: types.StringUnknown(), wantUnknown: true},
+		{name: "unknown source", source: types.StringUnknown(), content: types.StringNull(), wantUnknown: true},
+		{name: "missing file", source: types.StringValue(filepath.Join(t.TempDir(), "missing.html")), content: types.StringNull(), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := plannedTemplateHash(tt.source, tt.content)
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("HasError() = %v, want %v: %v", diags.HasError(), tt.wantErr, diags)
+			}
+			if tt.wantErr {
+				return
+			}
+			if tt.wantUnknown {
+				if !got.IsUnknown() {
+					t.Fatalf("expected unknown, got %s", got)
+				}
+				return
+			}
+			if !got.Equal(tt.want) {
+				t.Fatalf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,460 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var _ resource.Resource = &siteAccountSecurityResource{}
+var _ resource.ResourceWithConfigure = &siteAccountSecurityResource{}
+var _ resource.ResourceWithImportState = &siteAccountSecurityResource{}
+
+func NewSiteAccountSecurityResource() resource.Resource {
+	return &siteAccountSecurityResource{}
+}
+
+type siteAccountSecurityResource struct {
+	client *client.Client
+}
+
+type siteAccountSecurityResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	SiteID       types.String `tfsdk:"site_id"`
+	InvalidLogin types.Object `tfsdk:"invalid_login"`
+	Inactivity   types.Object `tfsdk:"inactivity"`
+	Reset        types.Object `tfsdk:"reset"`
+}
+
+type siteInvalidLoginModel struct {
+	Account types.Object `tfsdk:"account"`
+	IP      types.Object `tfsdk:"ip"`
+}
+
+type siteInvalidLoginAccountModel struct {
+	Enabled              types.Bool   `tfsdk:"enabled"`
+	Action               types.String `tfsdk:"action"`
+	AttemptPeriodMinutes types.Int64  `tfsdk:"attempt_period_minutes"`
+	AttemptRetries       types.Int64  `tfsdk:"attempt_retries"`
+	LockoutMinutes       types.Int64  `tfsdk:"lockout_minutes"`
+}
+
+type siteInvalidLoginIPModel struct {
+	Enabled              types.Bool   `tfsdk:"enabled"`
+	AttemptPeriodMinutes types.Int64  `tfsdk:"attempt_period_minutes"`
+	AttemptRetries       types.Int64  `tfsdk:"attempt_retries"`
+	IncorrectLoginType   types.String `tfsdk:"incorrect_login_type"`
+}
+
+type siteInactivityModel struct {
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	Action          types.String `tfsdk:"action"`
+	MaxInactiveDays types.Int64  `tfsdk:"max_inactive_days"`
+}
+
+type sitePasswordResetModel struct {
+	Enabled                   types.Bool  `tfsdk:"enabled"`
+	ForceResetAfterFirstLogin types.Bool  `tfsdk:"force_reset_after_first_login"`
+	ExpirationEnabled         types.Bool  `tfsdk:"expiration_enabled"`
+	DaysToExpire              types.Int64 `tfsdk:"days_to_expire"`
+}
+
+var siteInvalidLoginAccountAttrTypes = map[string]attr.Type{
+	"enabled":                types.BoolType,
+	"action":                 types.StringType,
+	"attempt_period_minutes": types.Int64Type,
+	"attempt_retries":        types.Int64Type,
+	"lockout_minutes":        types.Int64Type,
+}
+
+var siteInvalidLoginIPAttrTypes = map[string]attr.Type{
+	"enabled":                types.BoolType,
+	"attempt_period_minutes": types.Int64Type,
+	"attempt_retries":        types.Int64Type,
+	"incorrect_login_type":   types.StringType,
+}
+
+var siteInvalidLoginAttrTypes = map[string]attr.Type{
+	"account": types.ObjectType{AttrTypes: siteInvalidLoginAccountAttrTypes},
+	"ip":      types.ObjectType{AttrTypes: siteInvalidLoginIPAttrTypes},
+}
+
+var siteInactivityAttrTypes = map[string]attr.Type{
+	"enabled":           types.BoolType,
+	"action":            types.StringType,
+	"max_inactive_days": types.Int64Type,
+}
+
+var sitePasswordResetAttrTypes = map[string]attr.Type{
+	"enabled":                       types.BoolType,
+	"force_reset_after_first_login": types.BoolType,
+	"expiration_enabled":            types.BoolType,
+	"days_to_expire":                types.Int64Type,
+}
+
+func (r *siteAccountSecurityResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_site_account_security"
+}
+
+func (r *siteAccountSecurityResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attemptPeriod := optionalComputedInt64("Window, in minutes, in which failed attempts are counted. Between 1 and 1440.", int64validator.Between(1, 1440))
+	attemptRetries := optionalComputedInt64("Failed attempts allowed within the window. Between 1 and 999.", int64validator.Between(1, 999))
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the account security policy of a Globalscape EFT site: invalid-login lockout, inactive account removal and password reset. Every section is read back into state; omitted sections are reported but not changed. Destroying this resource will only remove it from Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Site identifier.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site_id": schema.StringAttribute{
+				MarkdownDescription: "Site identifier whose account security policy is managed.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"invalid_login": schema.SingleNestedAttribute{
+				MarkdownDescription: "Reaction to repeated invalid logins (`accountPolicy.invalidLogin`). Read back from EFT even when omitted.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"account": schema.SingleNestedAttribute{
+						MarkdownDescription: "Lock out or disable the account.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Object{
+							objectplanmodifier.UseStateForUnknown(),
+						},
+						Attributes: map[string]schema.Attribute{
+							"enabled":                optionalComputedBool("Whether the account is acted on after repeated invalid logins."),
+							"action":                 optionalComputedString("`Lockout` or `Disable`.", stringvalidator.OneOf("Lockout", "Disable")),
+							"attempt_period_minutes": attemptPeriod,
+							"attempt_retries":        attemptRetries,
+							"lockout_minutes":        optionalComputedInt64("Lockout duration in minutes when `action` is `Lockout`. Between 1 and 99999.", int64validator.Between(1, 99999)),
+						},
+					},
+					"ip": schema.SingleNestedAttribute{
+						MarkdownDescription: "Ban the client IP address.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Object{
+							objectplanmodifier.UseStateForUnknown(),
+						},
+						Attributes: map[string]schema.Attribute{
+							"enabled":                optionalComputedBool("Whether the client address is banned after repeated invalid logins."),
+							"attempt_period_minutes": attemptPeriod,
+							"attempt_retries":        attemptRetries,
+							"incorrect_login_type":   optionalComputedString("Which failures are counted, e.g. `NameOrPassword`.", stringvalidator.LengthAtLeast(1)),
+						},
+					},
+				},
+			},
+			"inactivity": schema.SingleNestedAttribute{
+				MarkdownDescription: "Removal of inactive accounts (`accountPolicy.inactivity.removeInactiveAccounts`). Read back from EFT even when omitted.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"enabled":           optionalComputedBool("Whether inactive accounts are removed."),
+					"action":            optionalComputedString("`Delete` or `Disable`.", stringvalidator.OneOf("Delete", "Disable")),
+					"max_inactive_days": optionalComputedInt64("Days without a login after which an account is inactive. Between 1 and 9999.", int64validator.Between(1, 9999)),
+				},
+			},
+			"reset": schema.SingleNestedAttribute{
+				MarkdownDescription: "Password reset and expiration (`accountPolicy.reset`). Read back from EFT even when omitted.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"enabled":                       optionalComputedBool("Whether the password reset policy is enabled."),
+					"force_reset_after_first_login": optionalComputedBool("Require users to change their password after the first login."),
+					"expiration_enabled":            optionalComputedBool("Whether passwords expire. Unverified: sent as `reset.expiration.enabled`, a field name inferred from the admin user policy."),
+					"days_to_expire":                optionalComputedInt64("Password lifetime in days, between 1 and 999. Unverified: sent as `reset.expiration.daysToExpire`, a field name inferred from the admin user policy.", int64validator.Between(1, 999)),
+				},
+			},
+		},
+	}
+}
+
+func (r *siteAccountSecurityResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if c, ok := req.ProviderData.(*client.Client); ok {
+		r.client = c
+	}
+}
+
+func (r *siteAccountSecurityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var plan siteAccountSecurityResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	update, diags := plan.toAPIModel(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.UpdateSiteAccountSecurity(ctx, plan.SiteID.ValueString(), update)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update site account security", err.Error())
+		return
+	}
+
+	plan.ID = plan.SiteID
+	resp.Diagnostics.Append(plan.fromAPI(settings)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *siteAccountSecurityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var state siteAccountSecurityResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.SiteID.IsNull() {
+		state.SiteID = state.ID
+	}
+
+	settings, err := r.client.GetSiteAccountSecurity(ctx, state.SiteID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read site account security", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(state.fromAPI(settings)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *siteAccountSecurityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var plan siteAccountSecurityResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	update, diags := plan.toAPIModel(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.UpdateSiteAccountSecurity(ctx, plan.SiteID.ValueString(), update)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update site account security", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(plan.fromAPI(settings)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *siteAccountSecurityResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The account security policy is part of the site and cannot be deleted via the API.
+	// Removing the resource from Terraform state only; the site keeps its configuration.
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *siteAccountSecurityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site_id"), req.ID)...)
+}
+
+// toAPIModel only sends the configured sections and the known values within
+// them; EFT leaves everything else unchanged.
+func (m *siteAccountSecurityResourceModel) toAPIModel(ctx context.Context) (client.SiteAccountSecurity, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	policy := client.AccountPolicy{}
+
+	if knownObject(m.InvalidLogin) {
+		var il siteInvalidLoginModel
+		diags.Append(m.InvalidLogin.As(ctx, &il, basetypes.ObjectAsOptions{})...)
+		policy.InvalidLogin = &client.InvalidLoginPolicy{}
+		if knownObject(il.Account) {
+			var a siteInvalidLoginAccountModel
+			diags.Append(il.Account.As(ctx, &a, basetypes.ObjectAsOptions{})...)
+			policy.InvalidLogin.Account = &client.InvalidLoginAccount{
+				Enabled:              boolPointer(a.Enabled),
+				Action:               stringPointer(a.Action),
+				AttemptPeriodMinutes: int64Pointer(a.AttemptPeriodMinutes),
+				AttemptRetries:       int64Pointer(a.AttemptRetries),
+				LockoutMinutes:       int64Pointer(a.LockoutMinutes),
+			}
+		}
+		if knownObject(il.IP) {
+			var ip siteInvalidLoginIPModel
+			diags.Append(il.IP.As(ctx, &ip, basetypes.ObjectAsOptions{})...)
+			policy.InvalidLogin.IP = &client.InvalidLoginIP{
+				Enabled:              boolPointer(ip.Enabled),
+				AttemptPeriodMinutes: int64Pointer(ip.AttemptPeriodMinutes),
+				AttemptRetries:       int64Pointer(ip.AttemptRetries),
+				IncorrectLoginType:   stringPointer(ip.IncorrectLoginType),
+			}
+		}
+	}
+
+	if knownObject(m.Inactivity) {
+		var in siteInactivityModel
+		diags.Append(m.Inactivity.As(ctx, &in, basetypes.ObjectAsOptions{})...)
+		policy.Inactivity = &client.InactivityPolicy{
+			RemoveInactiveAccounts: &client.RemoveInactiveAccounts{
+				Enabled:         boolPointer(in.Enabled),
+				Action:          stringPointer(in.Action),
+				MaxInactiveDays: int64Pointer(in.MaxInactiveDays),
+			},
+		}
+	}
+
+	if knownObject(m.Reset) {
+		var rs sitePasswordResetModel
+		diags.Append(m.Reset.As(ctx, &rs, basetypes.ObjectAsOptions{})...)
+		policy.Reset = &client.PasswordResetPolicy{
+			Enabled:                   boolPointer(rs.Enabled),
+			ForceResetAfterFirstLogin: boolPointer(rs.ForceResetAfterFirstLogin),
+		}
+		exp := client.PasswordExpiration{
+			Enabled:      boolPointer(rs.ExpirationEnabled),
+			DaysToExpire: int64Pointer(rs.DaysToExpire),
+		}
+		if exp.Enabled != nil || exp.DaysToExpire != nil {
+			policy.Reset.Expiration = &exp
+		}
+	}
+
+	return client.SiteAccountSecurity{AccountPolicy: &policy}, diags
+}
+
+// fromAPI refreshes every section so that changes made outside Terraform,
+// including in sections that are not configured, show up in state.
+func (m *siteAccountSecurityResourceModel) fromAPI(settings *client.SiteAccountSecurity) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	policy := settings.AccountPolicy
+	if policy == nil {
+		policy = &client.AccountPolicy{}
+	}
+
+	il := policy.InvalidLogin
+	if il == nil {
+		il = &client.InvalidLoginPolicy{}
+	}
+	a := il.Account
+	if a == nil {
+		a = &client.InvalidLoginAccount{}
+	}
+	account, d := types.ObjectValue(siteInvalidLoginAccountAttrTypes, map[string]attr.Value{
+		"enabled":                boolFromPointer(a.Enabled),
+		"action":                 stringFromPointer(a.Action),
+		"attempt_period_minutes": int64FromPointer(a.AttemptPeriodMinutes),
+		"attempt_retries":        int64FromPointer(a.AttemptRetries),
+		"lockout_minutes":        int64FromPointer(a.LockoutMinutes),
+	})
+	diags.Append(d...)
+	ip := il.IP
+	if ip == nil {
+		ip = &client.InvalidLoginIP{}
+	}
+	ipBan, d := types.ObjectValue(siteInvalidLoginIPAttrTypes, map[string]attr.Value{
+		"enabled":                boolFromPointer(ip.Enabled),
+		"attempt_period_minutes": int64FromPointer(ip.AttemptPeriodMinutes),
+		"attempt_retries":        int64FromPointer(ip.AttemptRetries),
+		"incorrect_login_type":   stringFromPointer(ip.IncorrectLoginType),
+	})
+	diags.Append(d...)
+	invalidLogin, d := types.ObjectValue(siteInvalidLoginAttrTypes, map[string]attr.Value{
+		"account": account,
+		"ip":      ipBan,
+	})
+	diags.Append(d...)
+
+	ria := &client.RemoveInactiveAccounts{}
+	if policy.Inactivity != nil && policy.Inactivity.RemoveInactiveAccounts != nil {
+		ria = policy.Inactivity.RemoveInactiveAccounts
+	}
+	inactivity, d := types.ObjectValue(siteInactivityAttrTypes, map[string]attr.Value{
+		"enabled":           boolFromPointer(ria.Enabled),
+		"action":            stringFromPointer(ria.Action),
+		"max_inactive_days": int64FromPointer(ria.MaxInactiveDays),
+	})
+	diags.Append(d...)
+
+	rs := policy.Reset
+	if rs == nil {
+		rs = &client.PasswordResetPolicy{}
+	}
+	exp := rs.Expiration
+	if exp == nil {
+		exp = &client.PasswordExpiration{}
+	}
+	reset, d := types.ObjectValue(sitePasswordResetAttrTypes, map[string]attr.Value{
+		"enabled":                       boolFromPointer(rs.Enabled),
+		"force_reset_after_first_login": boolFromPointer(rs.ForceResetAfterFirstLogin),
+		"expiration_enabled":            boolFromPointer(exp.Enabled),
+		"days_to_expire":                int64FromPointer(exp.DaysToExpire),
+	})
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return diags
+	}
+	m.InvalidLogin = invalidLogin
+	m.Inactivity = inactivity
+	m.Reset = reset
+	return diags
+}
+
+func knownObject(v types.Object) bool {
+	return !v.IsNull() && !v.IsUnknown()
+}
@@ -0,0 +1,205 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSiteAccountSecurityFromAPI(t *testing.T) {
+	enabled, retries, action := true, int64(5), "Lockout"
+	settings := &client.SiteAccountSecurity{AccountPolicy: &client.AccountPolicy{
+		InvalidLogin: &client.InvalidLoginPolicy{
+			Account: &client.InvalidLoginAccount{Enabled: &enabled, Action: &action, AttemptRetries: &retries},
+		},
+		Reset: &client.PasswordResetPolicy{Enabled: &enabled},
+	}}
+
+	// An imported resource has no sections yet; every section must be filled.
+	m := siteAccountSecurityResourceModel{
+		InvalidLogin: types.ObjectNull(siteInvalidLoginAttrTypes),
+		Inactivity:   types.ObjectNull(siteInactivityAttrTypes),
+		Reset:        types.ObjectNull(sitePasswordResetAttrTypes),
+	}
+	if diags := m.fromAPI(settings); diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+
+	if m.InvalidLogin.IsNull() || m.Inactivity.IsNull() || m.Reset.IsNull() {
+		t.Fatalf("sections not filled: %v", m)
+	}
+	account := m.InvalidLogin.Attributes()["account"].(types.Object).Attributes()
+	if account["action"].(types.String).ValueString() != "Lockout" || account["attempt_retries"].(types.Int64).ValueInt64() != 5 {
+		t.Fatalf("unexpected account %v", account)
+	}
+	if !account["lockout_minutes"].IsNull() {
+		t.Fatalf("lockout_minutes = %v, want null when EFT omits it", account["lockout_minutes"])
+	}
+	if ip := m.InvalidLogin.Attributes()["ip"].(types.Object); ip.IsNull() || !ip.Attributes()["enabled"].IsNull() {
+		t.Fatalf("unexpected ip %v", ip)
+	}
+	if !m.Reset.Attributes()["enabled"].(types.Bool).ValueBool() {
+		t.Fatalf("unexpected reset %v", m.Reset)
+	}
+
+	// Missing policy still yields known sections.
+	empty := siteAccountSecurityResourceModel{}
+	if diags := empty.fromAPI(&client.SiteAccountSecurity{}); diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+	if empty.Inactivity.IsNull() || empty.Inactivity.IsUnknown() {
+		t.Fatalf("inactivity = %v, want a known object", empty.Inactivity)
+	}
+}
+
+func TestSiteAccountSecurityToAPIModel(t *testing.T) {
+	ctx := context.Background()
+
+	account := types.ObjectValueMust(siteInvalidLoginAccountAttrTypes, map[string]attr.Value{
+		"enabled":                types.BoolValue(true),
+		"action":                 types.StringValue("Lockout"),
+		"attempt_period_minutes": types.Int64Unknown(),
+		"attempt_retries":        types.Int64Value(5),
+		"lockout_minutes":        types.Int64Null(),
+	})
+	invalidLogin := types.ObjectValueMust(siteInvalidLoginAttrTypes, map[string]attr.Value{
+		"account": account,
+		"ip":      types.ObjectUnknown(siteInvalidLoginIPAttrTypes),
+	})
+	resetNoExpiration := types.ObjectValueMust(sitePasswordResetAttrTypes, map[string]attr.Value{
+		"enabled":                       types.BoolValue(true),
+		"force_reset_after_first_login": types.BoolUnknown(),
+		"expiration_enabled":            types.BoolUnknown(),
+		"days_to_expire":                types.Int64Unknown(),
+	})
+	resetExpiration := types.ObjectValueMust(sitePasswordResetAttrTypes, map[string]attr.Value{
+		"enabled":                       types.BoolUnknown(),
+		"force_reset_after_first_login": types.BoolUnknown(),
+		"expiration_enabled":            types.BoolValue(true),
+		"days_to_expire":                types.Int64Value(90),
+	})
+
+	tests := []struct {
+		name  string
+		model siteAccountSecurityResourceModel
+		want  string
+	}{
+		{
+			name: "omitted sections are not sent",
+			model: siteAccountSecurityResourceModel{
+				InvalidLogin: types.ObjectUnknown(siteInvalidLoginAttrTypes),
+				Inactivity:   types.ObjectNull(siteInactivityAttrTypes),
+				Reset:        types.ObjectUnknown(sitePasswordResetAttrTypes),
+			},
+			want: `{"accountPolicy":{}}`,
+		},
+		{
+			name: "only known values sent",
+			model: siteAccountSecurityResourceModel{
+				InvalidLogin: invalidLogin,
+				Inactivity:   types.ObjectUnknown(siteInactivityAttrTypes),
+				Reset:        resetNoExpiration,
+			},
+			want: `{"accountPolicy":{"invalidLogin":{"account":{"enabled":true,"action":"Lockout","attemptRetries":5}},"reset":{"enabled":true}}}`,
+		},
+		{
+			name: "expiration sent when set",
+			model: siteAccountSecurityResourceModel{
+				InvalidLogin: types.ObjectUnknown(siteInvalidLoginAttrTypes),
+				Inactivity:   types.ObjectUnknown(siteInactivityAttrTypes),
+				Reset:        resetExpiration,
+			},
+			want: `{"accountPolicy":{"reset":{"expiration":{"enabled":true,"daysToExpire":90}}}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := tt.model.toAPIModel(ctx)
+			if diags.HasError() {
+				t.Fatalf("unexpected errors: %v", diags)
+			}
+			body, err := json.Marshal(got)
+			if err != nil {
+				t.Fatalf("marshal: %v", err)
+			}
+			if string(body) != tt.want {
+				t.Errorf("toAPIModel() =\n%s\nwant\n%s", body, tt.want)
+			}
+		})
+	}
+}
+
+// accountSecurityInt64Attribute walks the nested schema down to a numeric
+// attribute.
+func accountSecurityInt64Attribute(t *testing.T, names ...string) schema.Int64Attribute {
+	t.Helper()
+	var resp resource.SchemaResponse
+	(&siteAccountSecurityResource{}).Schema(context.Background(), resource.SchemaRequest{}, &resp)
+
+	attributes := resp.Schema.Attributes
+	for _, name := range names[:len(names)-1] {
+		nested, ok := attributes[name].(schema.SingleNestedAttribute)
+		if !ok {
+			t.Fatalf("%s is not a nested attribute", name)
+		}
+		attributes = nested.Attributes
+	}
+	a, ok := attributes[names[len(names)-1]].(schema.Int64Attribute)
+	if !ok {
+		t.Fatalf("%v is not a number attribute", names)
+	}
+	return a
+}
+
+func TestSiteAccountSecurityNumericRanges(t *testing.T) {
+	tests := []struct {
+		path []string
+		min  int64
+		max  int64
+	}{
+		{path: []string{"invalid_login", "account", "attempt_period_minutes"}, min: 1, max: 1440},
+		{path: []string{"invalid_login", "account", "attempt_retries"}, min: 1, max: 999},
+		{path: []string{"invalid_login", "account", "lockout_minutes"}, min: 1, max: 99999},
+		{path: []string{"invalid_login", "ip", "attempt_period_minutes"}, min: 1, max: 1440},
+		{path: []string{"invalid_login", "ip", "attempt_retries"}, min: 1, max: 999},
+		{path: []string{"inactivity", "max_inactive_days"}, min: 1, max: 9999},
+		{path: []string{"reset", "days_to_expire"}, min: 1, max: 999},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.path, "."), func(t *testing.T) {
+			a := accountSecurityInt64Attribute(t, tt.path...)
+			for value, wantErr := range map[int64]bool{
+				-1:         true,
+				tt.min - 1: true,
+				tt.min:     false,
+				tt.max:     false,
+				tt.max + 1: true,
+			} {
+				var diags diag.Diagnostics
+				for _, v := range a.Validators {
+					var resp validator.Int64Response
+					v.ValidateInt64(context.Background(), validator.Int64Request{
+						Path:        path.Root(tt.path[0]),
+						ConfigValue: types.Int64Value(value),
+					}, &resp)
+					diags.Append(resp.Diagnostics...)
+				}
+				if diags.HasError() != wantErr {
+					t.Errorf("value %d: error = %t, want %t", value, diags.HasError(), wantErr)
+				}
+			}
+		})
+	}
+}
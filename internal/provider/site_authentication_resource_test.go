@@ -0,0 +1,220 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestEquivalentConnectionStrings(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want bool
+	}{
+		{name: "identical", a: "DSN=eft;UID=svc;PWD=secret", b: "DSN=eft;UID=svc;PWD=secret", want: true},
+		{name: "empty value with space", a: "DSN=eft;UID=svc;PWD= ", b: "DSN=eft;UID=svc;PWD=", want: true},
+		{name: "trailing semicolon", a: "DSN=eft;UID=svc;", b: "DSN=eft;UID=svc", want: true},
+		{name: "key case and spacing", a: "dsn = eft ; uid=svc", b: "DSN=eft;UID=svc", want: true},
+		{name: "different order", a: "UID=svc;DSN=eft", b: "DSN=eft;UID=svc", want: true},
+		{name: "braced value with semicolon", a: "DSN=eft;PWD={a;b}", b: "DSN=eft;PWD={a;b};", want: true},
+		{name: "value changed", a: "DSN=eft;UID=svc", b: "DSN=eft;UID=other", want: false},
+		{name: "value case matters", a: "DSN=EFT", b: "DSN=eft", want: false},
+		{name: "key added", a: "DSN=eft", b: "DSN=eft;UID=svc", want: false},
+		{name: "braced value changed", a: "PWD={a;b}", b: "PWD={a;c}", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := equivalentConnectionStrings(tt.a, tt.b); got != tt.want {
+				t.Fatalf("equivalentConnectionStrings(%q, %q) = %t, want %t", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSiteAuthenticationFromAPI(t *testing.T) {
+	adAuth := &client.SiteAuthentication{Attributes: client.SiteAuthenticationAttributes{UserAuth: client.UserAuth{
+		Type: client.SiteAuthTypeAD,
+		AD:   &client.ADAuth{Domain: "EXAMPLE"},
+	}}}
+	ldapAuth := &client.SiteAuthentication{Attributes: client.SiteAuthenticationAttributes{UserAuth: client.UserAuth{
+		Type: client.SiteAuthTypeLDAP,
+		LDAP: &client.LDAPAuth{Server: "ldap.example.com", Port: 636},
+	}}}
+	odbcAuth := &client.SiteAuthentication{Attributes: client.SiteAuthenticationAttributes{UserAuth: client.UserAuth{
+		Type: client.SiteAuthTypeODBC,
+		ODBC: &client.ODBCAuth{ConnectionString: "DSN=eft;PWD=", EncryptUserPassword: true},
+	}}}
+
+	tests := []struct {
+		name     string
+		prior    siteAuthenticationResourceModel
+		auth     *client.SiteAuthentication
+		wantType string
+		wantAD   bool
+		wantLDAP bool
+		wantODBC string
+	}{
+		{
+			name:     "omitted ad block stays empty",
+			prior:    siteAuthenticationResourceModel{Type: types.StringValue(client.SiteAuthTypeAD)},
+			auth:     adAuth,
+			wantType: client.SiteAuthTypeAD,
+		},
+		{
+			name: "configured ad block refreshed",
+			prior: siteAuthenticationResourceModel{
+				Type: types.StringValue(client.SiteAuthTypeAD),
+				AD:   &siteAuthADModel{Domain: types.StringUnknown()},
+			},
+			auth:     adAuth,
+			wantType: client.SiteAuthTypeAD,
+			wantAD:   true,
+		},
+		{
+			name:     "import fills required ldap block",
+			prior:    siteAuthenticationResourceModel{Type: types.StringNull()},
+			auth:     ldapAuth,
+			wantType: client.SiteAuthTypeLDAP,
+			wantLDAP: true,
+		},
+		{
+			name:     "import leaves optional ad block empty",
+			prior:    siteAuthenticationResourceModel{Type: types.StringNull()},
+			auth:     adAuth,
+			wantType: client.SiteAuthTypeAD,
+		},
+		{
+			name: "type switched outside terraform drops stale block",
+			prior: siteAuthenticationResourceModel{
+				Type: types.StringValue(client.SiteAuthTypeLDAP),
+				LDAP: &siteAuthLDAPModel{Server: types.StringValue("ldap.example.com")},
+			},
+			auth:     odbcAuth,
+			wantType: client.SiteAuthTypeODBC,
+		},
+		{
+			name: "normalized connection string keeps configured value",
+			prior: siteAuthenticationResourceModel{
+				Type: types.StringValue(client.SiteAuthTypeODBC),
+				ODBC: &siteAuthODBCModel{ConnectionString: types.StringValue("DSN=eft;PWD= ;")},
+			},
+			auth:     odbcAuth,
+			wantType: client.SiteAuthTypeODBC,
+			wantODBC: "DSN=eft;PWD= ;",
+		},
+		{
+			name: "changed connection string reported",
+			prior: siteAuthenticationResourceModel{
+				Type: types.StringValue(client.SiteAuthTypeODBC),
+				ODBC: &siteAuthODBCModel{ConnectionString: types.StringValue("DSN=other")},
+			},
+			auth:     odbcAuth,
+			wantType: client.SiteAuthTypeODBC,
+			wantODBC: "DSN=eft;PWD=",
+		},
+		{
+			name:     "import fills odbc block",
+			prior:    siteAuthenticationResourceModel{Type: types.StringNull()},
+			auth:     odbcAuth,
+			wantType: client.SiteAuthTypeODBC,
+			wantODBC: "DSN=eft;PWD=",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := tt.prior
+			m.fromAPI(tt.auth)
+
+			if m.Type.ValueString() != tt.wantType {
+				t.Fatalf("type = %q, want %q", m.Type.ValueString(), tt.wantType)
+			}
+			if (m.AD != nil) != tt.wantAD {
+				t.Fatalf("ad block present = %t, want %t", m.AD != nil, tt.wantAD)
+			}
+			if (m.LDAP != nil) != tt.wantLDAP {
+				t.Fatalf("ldap block present = %t, want %t", m.LDAP != nil, tt.wantLDAP)
+			}
+			if tt.wantODBC == "" {
+				if m.ODBC != nil {
+					t.Fatalf("unexpected odbc block %+v", m.ODBC)
+				}
+				return
+			}
+			if m.ODBC == nil || m.ODBC.ConnectionString.ValueString() != tt.wantODBC {
+				t.Fatalf("odbc connection string = %+v, want %q", m.ODBC, tt.wantODBC)
+			}
+		})
+	}
+}
+
+func TestRefreshIntervalToAPI(t *testing.T) {
+	tests := []struct {
+		name  string
+		value types.Int64
+		want  *client.RefreshInterval
+	}{
+		{name: "unknown not sent", value: types.Int64Unknown()},
+		{name: "unset inherits", value: types.Int64Null(), want: &client.RefreshInterval{Inherited: true}},
+		{name: "zero disables", value: types.Int64Value(0), want: &client.RefreshInterval{}},
+		{name: "minutes set", value: types.Int64Value(30), want: &client.RefreshInterval{Enabled: true, Minutes: 30}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := refreshIntervalToAPI(tt.value)
+			if (got == nil) != (tt.want == nil) || (got != nil && *got != *tt.want) {
+				t.Fatalf("refreshIntervalToAPI(%s) = %+v, want %+v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRefreshIntervalFromAPI(t *testing.T) {
+	tests := []struct {
+		name string
+		ri   *client.RefreshInterval
+		want types.Int64
+	}{
+		{name: "not returned", ri: nil, want: types.Int64Null()},
+		{name: "inherited", ri: &client.RefreshInterval{Inherited: true, Enabled: true, Minutes: 60}, want: types.Int64Null()},
+		{name: "disabled", ri: &client.RefreshInterval{Minutes: 60}, want: types.Int64Value(0)},
+		{name: "enabled", ri: &client.RefreshInterval{Enabled: true, Minutes: 60}, want: types.Int64Value(60)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := refreshIntervalFromAPI(tt.ri); !got.Equal(tt.want) {
+				t.Fatalf("refreshIntervalFromAPI(%+v) = %s, want %s", tt.ri, got, tt.want)
+			}
+		})
+	}
+}
+
+// An interval left unset must stay inherited on every apply, not only the
+// first one.
+func TestSiteAuthenticationRefreshIntervalUnsetAfterCreate(t *testing.T) {
+	m := siteAuthenticationResourceModel{
+		Type: types.StringValue(client.SiteAuthTypeAD),
+		AD:   &siteAuthADModel{Domain: types.StringValue("EXAMPLE"), RefreshIntervalMinutes: types.Int64Null()},
+	}
+
+	for apply := 1; apply <= 2; apply++ {
+		sent := m.toAPIModel("").AD.RefreshInterval
+		if sent == nil || !sent.Inherited {
+			t.Fatalf("apply %d: refresh interval sent as %+v, want inherited", apply, sent)
+		}
+
+		// EFT reports the server value it inherits.
+		m.fromAPI(&client.SiteAuthentication{Attributes: client.SiteAuthenticationAttributes{UserAuth: client.UserAuth{
+			Type: client.SiteAuthTypeAD,
+			AD:   &client.ADAuth{Domain: "EXAMPLE", RefreshInterval: &client.RefreshInterval{Inherited: true, Enabled: true, Minutes: 15}},
+		}}})
+		if !m.AD.RefreshIntervalMinutes.IsNull() {
+			t.Fatalf("apply %d: refresh_interval_minutes = %s, want null", apply, m.AD.RefreshIntervalMinutes)
+		}
+	}
+}
@@ -0,0 +1,416 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &siteContentIntegrityProfileResource{}
+var _ resource.ResourceWithConfigure = &siteContentIntegrityProfileResource{}
+var _ resource.ResourceWithImportState = &siteContentIntegrityProfileResource{}
+
+func NewSiteContentIntegrityProfileResource() resource.Resource {
+	return &siteContentIntegrityProfileResource{}
+}
+
+type siteContentIntegrityProfileResource struct {
+	client *client.Client
+}
+
+type siteContentIntegrityProfileResourceModel struct {
+	ID                  types.String              `tfsdk:"id"`
+	SiteID              types.String              `tfsdk:"site_id"`
+	ProfileID           types.String              `tfsdk:"profile_id"`
+	Name                types.String              `tfsdk:"name"`
+	Host                types.String              `tfsdk:"host"`
+	Port                types.Int64               `tfsdk:"port"`
+	Path                types.String              `tfsdk:"path"`
+	Mode                types.String              `tfsdk:"mode"`
+	ScanLimitBytes      types.Int64               `tfsdk:"scan_limit_bytes"`
+	OnConnectivityError types.String              `tfsdk:"on_connectivity_error"`
+	OnHTTPError         types.String              `tfsdk:"on_http_error"`
+	OnICAPViolation     types.String              `tfsdk:"on_icap_violation"`
+	OnICAPRedaction     types.String              `tfsdk:"on_icap_redaction"`
+	AuditHeaders        []types.String            `tfsdk:"audit_headers"`
+	HeaderOverrides     *icapHeaderOverridesModel `tfsdk:"header_overrides"`
+}
+
+type icapHeaderOverridesModel struct {
+	HTTPHost             types.String `tfsdk:"http_host"`
+	XClientIP            types.String `tfsdk:"x_client_ip"`
+	XServerIP            types.String `tfsdk:"x_server_ip"`
+	XSubscriberIP        types.String `tfsdk:"x_subscriber_ip"`
+	XAuthenticatedUser   types.String `tfsdk:"x_authenticated_user"`
+	XAuthenticatedGroups types.String `tfsdk:"x_authenticated_groups"`
+}
+
+func (r *siteContentIntegrityProfileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_site_content_integrity_profile"
+}
+
+func (r *siteContentIntegrityProfileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	failureAction := func(description, defaultValue string) schema.StringAttribute {
+		return schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("%s Either `%s` or `%s`. Defaults to `%s`.", description, client.ICAPContinue, client.ICAPFail, defaultValue),
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(defaultValue),
+			Validators: []validator.String{
+				stringvalidator.OneOf(client.ICAPContinue, client.ICAPFail),
+			},
+		}
+	}
+	headerOverride := func(header string) schema.StringAttribute {
+		return schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Value sent in the `%s` header. The header is not overridden when unset.", header),
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Content Integrity Control profile of a Globalscape EFT site: an ICAP server that event rules send files to for antivirus or DLP scanning.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier in the form `<site_id>/<profile_id>`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site_id": schema.StringAttribute{
+				MarkdownDescription: "Site identifier that owns the profile.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"profile_id": schema.StringAttribute{
+				MarkdownDescription: "Profile GUID assigned by EFT, as referenced by event rule actions.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Profile name shown in the EFT administrator.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"host": schema.StringAttribute{
+				MarkdownDescription: "Host name or IP address of the ICAP server.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"port": schema.Int64Attribute{
+				MarkdownDescription: "ICAP server port. Defaults to `1344`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1344),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "ICAP service path, e.g. `/avscan`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"mode": schema.StringAttribute{
+				MarkdownDescription: "ICAP mode: `REQMOD` or `RESPMOD`. Defaults to `REQMOD`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("REQMOD"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("REQMOD", "RESPMOD"),
+				},
+			},
+			"scan_limit_bytes": schema.Int64Attribute{
+				MarkdownDescription: "Only the first bytes of each file, up to this limit, are sent for scanning. Whole files are sent when unset.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"on_connectivity_error": failureAction("Action when the ICAP server cannot be reached.", client.ICAPContinue),
+			"on_http_error":         failureAction("Action when the ICAP server answers with an HTTP error.", client.ICAPContinue),
+			"on_icap_violation":     failureAction("Action when the ICAP server reports a violation.", client.ICAPFail),
+			"on_icap_redaction":     failureAction("Action when the ICAP server redacts the content.", client.ICAPContinue),
+			"audit_headers": schema.ListAttribute{
+				MarkdownDescription: "ICAP response headers written to the audit database.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"header_overrides": schema.SingleNestedBlock{
+				MarkdownDescription: "Values sent in place of the ICAP request headers EFT would generate. Not managed when omitted.",
+				Attributes: map[string]schema.Attribute{
+					"http_host":              headerOverride("Host"),
+					"x_client_ip":            headerOverride("X-Client-IP"),
+					"x_server_ip":            headerOverride("X-Server-IP"),
+					"x_subscriber_ip":        headerOverride("X-Subscriber-IP"),
+					"x_authenticated_user":   headerOverride("X-Authenticated-User"),
+					"x_authenticated_groups": headerOverride("X-Authenticated-Groups"),
+				},
+			},
+		},
+	}
+}
+
+func (r *siteContentIntegrityProfileResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if c, ok := req.ProviderData.(*client.Client); ok {
+		r.client = c
+	}
+}
+
+func (r *siteContentIntegrityProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var plan siteContentIntegrityProfileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, err := r.client.CreateSiteContentIntegrityProfile(ctx, plan.SiteID.ValueString(), plan.toAPIModel())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create content integrity profile", err.Error())
+		return
+	}
+
+	plan.fromAPI(profile)
+	plan.ID = types.StringValue(fmt.Sprintf("%s/%s", plan.SiteID.ValueString(), profile.ID))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *siteContentIntegrityProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var state siteContentIntegrityProfileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profiles, err := r.client.ListSiteContentIntegrityProfiles(ctx, state.SiteID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read content integrity profiles", err.Error())
+		return
+	}
+
+	found := false
+	for _, p := range profiles {
+		if strings.EqualFold(p.ID, state.ProfileID.ValueString()) {
+			found = true
+			break
+		}
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// The list only carries profile names, so the profile is fetched individually.
+	profile, err := r.client.GetSiteContentIntegrityProfile(ctx, state.SiteID.ValueString(), state.ProfileID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read content integrity profile", err.Error())
+		return
+	}
+
+	state.fromAPI(profile)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *siteContentIntegrityProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var plan siteContentIntegrityProfileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, err := r.client.UpdateSiteContentIntegrityProfile(ctx, plan.SiteID.ValueString(), plan.ProfileID.ValueString(), plan.toAPIModel())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update content integrity profile", err.Error())
+		return
+	}
+
+	plan.fromAPI(profile)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *siteContentIntegrityProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var state siteContentIntegrityProfileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteSiteContentIntegrityProfile(ctx, state.SiteID.ValueString(), state.ProfileID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Failed to delete content integrity profile", err.Error())
+	}
+}
+
+func (r *siteContentIntegrityProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	siteID, profileID, ok := strings.Cut(req.ID, "/")
+	if !ok || siteID == "" || profileID == "" {
+		resp.Diagnostics.AddError("Invalid import identifier", "Expected identifier in the form <site_id>/<profile_id>")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site_id"), siteID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("profile_id"), profileID)...)
+}
+
+func (m *siteContentIntegrityProfileResourceModel) toAPIModel() client.ContentIntegrityAttributes {
+	attrs := client.ContentIntegrityAttributes{
+		ProfileName: m.Name.ValueString(),
+		Host:        client.MaskedString(m.Host.ValueString()),
+		Port:        m.Port.ValueInt64(),
+		Path:        client.MaskedString(m.Path.ValueString()),
+		Mode:        m.Mode.ValueString(),
+		ScanLimit:   &client.ICAPScanLimit{},
+		ResponseHandling: &client.ICAPResponseHandling{
+			ContinueOnConnectivityError: m.OnConnectivityError.ValueString(),
+			ContinueOnHTTPError:         m.OnHTTPError.ValueString(),
+			ContinueOnICAPViolation:     m.OnICAPViolation.ValueString(),
+			ContinueOnICAPRedaction:     m.OnICAPRedaction.ValueString(),
+			CustomHeadersToAudit: client.ICAPAuditHeaders{
+				Enabled: len(m.AuditHeaders) > 0,
+				Headers: make([]string, 0, len(m.AuditHeaders)),
+			},
+		},
+	}
+
+	if limit := int64Pointer(m.ScanLimitBytes); limit != nil {
+		attrs.ScanLimit = &client.ICAPScanLimit{Enabled: true, Bytes: *limit}
+	}
+
+	for _, h := range m.AuditHeaders {
+		attrs.ResponseHandling.CustomHeadersToAudit.Headers = append(attrs.ResponseHandling.CustomHeadersToAudit.Headers, h.ValueString())
+	}
+
+	if o := m.HeaderOverrides; o != nil {
+		attrs.HeaderOverrides = &client.ICAPHeaderOverrides{
+			HTTPHost:             headerOverrideToAPI(o.HTTPHost),
+			XClientIP:            headerOverrideToAPI(o.XClientIP),
+			XServerIP:            headerOverrideToAPI(o.XServerIP),
+			XSubscriberIP:        headerOverrideToAPI(o.XSubscriberIP),
+			XAuthenticatedUser:   headerOverrideToAPI(o.XAuthenticatedUser),
+			XAuthenticatedGroups: headerOverrideToAPI(o.XAuthenticatedGroups),
+		}
+	}
+
+	return attrs
+}
+
+// fromAPI refreshes the model from EFT. Some EFT builds report host and path
+// as booleans; the configured values are kept in that case.
+func (m *siteContentIntegrityProfileResourceModel) fromAPI(profile *client.ContentIntegrityProfile) {
+	a := profile.Attributes
+	m.ProfileID = types.StringValue(profile.ID)
+	m.Name = types.StringValue(a.ProfileName)
+	if a.Host != "" {
+		m.Host = types.StringValue(string(a.Host))
+	}
+	if a.Path != "" {
+		m.Path = types.StringValue(string(a.Path))
+	}
+	m.Port = types.Int64Value(a.Port)
+	m.Mode = types.StringValue(a.Mode)
+
+	m.ScanLimitBytes = types.Int64Null()
+	if a.ScanLimit != nil && a.ScanLimit.Enabled {
+		m.ScanLimitBytes = types.Int64Value(a.ScanLimit.Bytes)
+	}
+
+	if h := a.ResponseHandling; h != nil {
+		m.OnConnectivityError = types.StringValue(h.ContinueOnConnectivityError)
+		m.OnHTTPError = types.StringValue(h.ContinueOnHTTPError)
+		m.OnICAPViolation = types.StringValue(h.ContinueOnICAPViolation)
+		m.OnICAPRedaction = types.StringValue(h.ContinueOnICAPRedaction)
+
+		m.AuditHeaders = nil
+		if h.CustomHeadersToAudit.Enabled && len(h.CustomHeadersToAudit.Headers) > 0 {
+			for _, header := range h.CustomHeadersToAudit.Headers {
+				m.AuditHeaders = append(m.AuditHeaders, types.StringValue(header))
+			}
+		}
+	}
+
+	if m.HeaderOverrides != nil {
+		o := a.HeaderOverrides
+		if o == nil {
+			o = &client.ICAPHeaderOverrides{}
+		}
+		m.HeaderOverrides = &icapHeaderOverridesModel{
+			HTTPHost:             headerOverrideFromAPI(o.HTTPHost),
+			XClientIP:            headerOverrideFromAPI(o.XClientIP),
+			XServerIP:            headerOverrideFromAPI(o.XServerIP),
+			XSubscriberIP:        headerOverrideFromAPI(o.XSubscriberIP),
+			XAuthenticatedUser:   headerOverrideFromAPI(o.XAuthenticatedUser),
+			XAuthenticatedGroups: headerOverrideFromAPI(o.XAuthenticatedGroups),
+		}
+	}
+}
+
+func headerOverrideToAPI(v types.String) client.ICAPHeaderOverride {
+	if v.IsNull() || v.IsUnknown() {
+		return client.ICAPHeaderOverride{}
+	}
+	return client.ICAPHeaderOverride{Enabled: true, Value: v.ValueString()}
+}
+
+func headerOverrideFromAPI(o client.ICAPHeaderOverride) types.String {
+	if !o.Enabled {
+		return types.StringNull()
+	}
+	return types.StringValue(o.Value)
+}
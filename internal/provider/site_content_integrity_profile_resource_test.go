@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testContentIntegrityProfile(t *testing.T, host, path string) *client.ContentIntegrityProfile {
+	t.Helper()
+
+	var profile client.ContentIntegrityProfile
+	body := `{"id":"53f00be5","attributes":{"profileName":"AV","host":` + host + `,"port":1344,"path":` + path + `,"mode":"REQMOD",
+		"scanLimit":{"enabled":true,"bytes":1048576},
+		"headerOverrides":{"xClientIp":{"enabled":true,"value":"10.0.0.1"}},
+		"responseHandling":{"continueOnConnectivityError":"Continue","continueOnHttpError":"Fail","continueOnIcapViolation":"Fail","continueOnIcapRedaction":"Fail",
+			"customHeadersToAudit":{"enabled":true,"headers":["X-Infection-Found"]}}}}`
+	if err := json.Unmarshal([]byte(body), &profile); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	return &profile
+}
+
+func TestSiteContentIntegrityProfileFromAPIHostPath(t *testing.T) {
+	tests := []struct {
+		name     string
+		host     string
+		path     string
+		prior    siteContentIntegrityProfileResourceModel
+		wantHost types.String
+		wantPath types.String
+	}{
+		{
+			name:     "values reported",
+			host:     `"icap.example.com"`,
+			path:     `"/avscan"`,
+			prior:    siteContentIntegrityProfileResourceModel{Host: types.StringValue("old"), Path: types.StringValue("/old")},
+			wantHost: types.StringValue("icap.example.com"),
+			wantPath: types.StringValue("/avscan"),
+		},
+		{
+			name:     "masked keeps configured values",
+			host:     `true`,
+			path:     `true`,
+			prior:    siteContentIntegrityProfileResourceModel{Host: types.StringValue("icap.example.com"), Path: types.StringValue("/avscan")},
+			wantHost: types.StringValue("icap.example.com"),
+			wantPath: types.StringValue("/avscan"),
+		},
+		{
+			// After an import there is nothing to keep; see the import docs.
+			name:     "masked on import stays null",
+			host:     `true`,
+			path:     `true`,
+			prior:    siteContentIntegrityProfileResourceModel{Host: types.StringNull(), Path: types.StringNull()},
+			wantHost: types.StringNull(),
+			wantPath: types.StringNull(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := tt.prior
+			m.fromAPI(testContentIntegrityProfile(t, tt.host, tt.path))
+
+			if !m.Host.Equal(tt.wantHost) || !m.Path.Equal(tt.wantPath) {
+				t.Errorf("host/path = %s %s, want %s %s", m.Host, m.Path, tt.wantHost, tt.wantPath)
+			}
+		})
+	}
+}
+
+func TestSiteContentIntegrityProfileFromAPI(t *testing.T) {
+	m := siteContentIntegrityProfileResourceModel{HeaderOverrides: &icapHeaderOverridesModel{}}
+	m.fromAPI(testContentIntegrityProfile(t, `"icap.example.com"`, `"/avscan"`))
+
+	if m.ProfileID.ValueString() != "53f00be5" || m.ScanLimitBytes.ValueInt64() != 1048576 || m.OnHTTPError.ValueString() != client.ICAPFail {
+		t.Errorf("profile not read back: %+v", m)
+	}
+	if len(m.AuditHeaders) != 1 || m.AuditHeaders[0].ValueString() != "X-Infection-Found" {
+		t.Errorf("audit headers = %v", m.AuditHeaders)
+	}
+	if m.HeaderOverrides.XClientIP.ValueString() != "10.0.0.1" || !m.HeaderOverrides.HTTPHost.IsNull() {
+		t.Errorf("header overrides = %+v", m.HeaderOverrides)
+	}
+
+	// The block is not managed unless configured.
+	unmanaged := siteContentIntegrityProfileResourceModel{}
+	unmanaged.fromAPI(testContentIntegrityProfile(t, `"icap.example.com"`, `"/avscan"`))
+	if unmanaged.HeaderOverrides != nil {
+		t.Errorf("header overrides = %+v, want nil", unmanaged.HeaderOverrides)
+	}
+}
+
+func TestSiteContentIntegrityProfileToAPIModel(t *testing.T) {
+	m := siteContentIntegrityProfileResourceModel{
+		Name:                types.StringValue("AV"),
+		Host:                types.StringValue("icap.example.com"),
+		Port:                types.Int64Value(1344),
+		Path:                types.StringValue("/avscan"),
+		Mode:                types.StringValue("REQMOD"),
+		ScanLimitBytes:      types.Int64Null(),
+		OnConnectivityError: types.StringValue(client.ICAPContinue),
+		OnHTTPError:         types.StringValue(client.ICAPFail),
+		OnICAPViolation:     types.StringValue(client.ICAPFail),
+		OnICAPRedaction:     types.StringValue(client.ICAPFail),
+	}
+
+	got := m.toAPIModel()
+	if got.Host != "icap.example.com" || got.Path != "/avscan" {
+		t.Errorf("host/path = %q %q", got.Host, got.Path)
+	}
+	if got.ScanLimit == nil || got.ScanLimit.Enabled {
+		t.Errorf("scan limit = %+v, want disabled", got.ScanLimit)
+	}
+	if h := got.ResponseHandling.CustomHeadersToAudit; h.Enabled || h.Headers == nil {
+		t.Errorf("audit headers = %+v, want disabled with an empty list", h)
+	}
+	if got.HeaderOverrides != nil {
+		t.Errorf("header overrides = %+v, want nil when the block is absent", got.HeaderOverrides)
+	}
+}
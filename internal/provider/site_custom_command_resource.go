@@ -0,0 +1,475 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &siteCustomCommandResource{}
+var _ resource.ResourceWithConfigure = &siteCustomCommandResource{}
+var _ resource.ResourceWithImportState = &siteCustomCommandResource{}
+
+func NewSiteCustomCommandResource() resource.Resource {
+	return &siteCustomCommandResource{}
+}
+
+type siteCustomCommandResource struct {
+	client *client.Client
+}
+
+type siteCustomCommandResourceModel struct {
+	ID                     types.String `tfsdk:"id"`
+	SiteID                 types.String `tfsdk:"site_id"`
+	CommandID              types.String `tfsdk:"command_id"`
+	Name                   types.String `tfsdk:"name"`
+	Description            types.String `tfsdk:"description"`
+	Enabled                types.Bool   `tfsdk:"enabled"`
+	Executable             types.String `tfsdk:"executable"`
+	Parameters             types.String `tfsdk:"parameters"`
+	TimeoutSeconds         types.Int64  `tfsdk:"timeout_seconds"`
+	LogFile                types.String `tfsdk:"log_file"`
+	RedirectOutputToClient types.Bool   `tfsdk:"redirect_output_to_client"`
+	MinParameters          types.Int64  `tfsdk:"min_parameters"`
+	MinParametersMessage   types.String `tfsdk:"min_parameters_message"`
+	PermissionGroupIDs     types.Set    `tfsdk:"permission_group_ids"`
+}
+
+func (r *siteCustomCommandResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_site_custom_command"
+}
+
+func (r *siteCustomCommandResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a custom FTP `SITE` command of a Globalscape EFT site, which runs an executable on the server when invoked by a client. Requires EFT 8.1.0 or later.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier in the form `<site_id>/<command_id>`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site_id": schema.StringAttribute{
+				MarkdownDescription: "Site identifier that owns the command.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"command_id": schema.StringAttribute{
+				MarkdownDescription: "Command GUID assigned by EFT.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Command name clients pass to `SITE`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Administrator description of the command.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether clients can run the command. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"executable": schema.StringAttribute{
+				MarkdownDescription: "Command line of the executable run on the EFT server, e.g. `c:\\windows\\system32\\cscript.exe \"c:\\scripts\\report.vbs\"`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"parameters": schema.StringAttribute{
+				MarkdownDescription: "Parameters appended to `executable`. Client arguments are referenced as `%1`, `%2`, and so on.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"timeout_seconds": schema.Int64Attribute{
+				MarkdownDescription: "Terminate the process after this many seconds. The process is not time-limited when unset.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"log_file": schema.StringAttribute{
+				MarkdownDescription: "Path of a log file, on the EFT server, receiving the process output. Output is not logged when unset.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"redirect_output_to_client": schema.BoolAttribute{
+				MarkdownDescription: "Send the process output to the client. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"min_parameters": schema.Int64Attribute{
+				MarkdownDescription: "Minimum number of arguments the client must pass. Not enforced when unset.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"min_parameters_message": schema.StringAttribute{
+				MarkdownDescription: "Message returned to the client when fewer than `min_parameters` arguments are passed.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("min_parameters")),
+				},
+			},
+			"permission_group_ids": schema.SetAttribute{
+				MarkdownDescription: "Identifiers of the permission groups allowed to run the command. Not managed when unset.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *siteCustomCommandResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if c, ok := req.ProviderData.(*client.Client); ok {
+		r.client = c
+	}
+}
+
+func (r *siteCustomCommandResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var plan siteCustomCommandResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.RequireServerVersion(ctx, client.CustomCommandsMinVersion); err != nil {
+		resp.Diagnostics.AddError("Custom commands are not supported", err.Error())
+		return
+	}
+
+	permissions, diags := plan.permissionsToAPI(ctx, nil)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	command, err := r.client.CreateSiteCustomCommand(ctx, plan.SiteID.ValueString(), plan.toAPIModel(), permissions)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create custom command", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(plan.fromAPI(ctx, command)...)
+	plan.ID = types.StringValue(fmt.Sprintf("%s/%s", plan.SiteID.ValueString(), command.ID))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *siteCustomCommandResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var state siteCustomCommandResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	commands, err := r.client.ListSiteCustomCommands(ctx, state.SiteID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read custom commands", err.Error())
+		return
+	}
+
+	found := false
+	for _, c := range commands {
+		if strings.EqualFold(c.ID, state.CommandID.ValueString()) {
+			found = true
+			break
+		}
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// The list only carries command names, so the command is fetched individually.
+	command, err := r.client.GetSiteCustomCommand(ctx, state.SiteID.ValueString(), state.CommandID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read custom command", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(state.fromAPI(ctx, command)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *siteCustomCommandResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var plan siteCustomCommandResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	siteID, commandID := plan.SiteID.ValueString(), plan.CommandID.ValueString()
+
+	// Users granted execution outside Terraform are kept, so the current
+	// permissions are read before the groups are replaced.
+	var current *client.CustomCommand
+	if !plan.PermissionGroupIDs.IsNull() {
+		var err error
+		current, err = r.client.GetSiteCustomCommand(ctx, siteID, commandID)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to read custom command", err.Error())
+			return
+		}
+	}
+
+	permissions, diags := plan.permissionsToAPI(ctx, current)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	command, err := r.client.UpdateSiteCustomCommand(ctx, siteID, commandID, plan.toAPIModel(), permissions)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update custom command", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(plan.fromAPI(ctx, command)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *siteCustomCommandResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var state siteCustomCommandResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteSiteCustomCommand(ctx, state.SiteID.ValueString(), state.CommandID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Failed to delete custom command", err.Error())
+	}
+}
+
+// ImportState accepts either the command GUID or its name after the site ID.
+func (r *siteCustomCommandResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	siteID, key, ok := strings.Cut(req.ID, "/")
+	if !ok || siteID == "" || key == "" {
+		resp.Diagnostics.AddError("Invalid import identifier", "Expected identifier in the form <site_id>/<command_id> or <site_id>/<command_name>")
+		return
+	}
+
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	commands, err := r.client.ListSiteCustomCommands(ctx, siteID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read custom commands", err.Error())
+		return
+	}
+
+	commandID := ""
+	for _, c := range commands {
+		if strings.EqualFold(c.ID, key) {
+			commandID = c.ID
+			break
+		}
+	}
+	if commandID == "" {
+		for _, c := range commands {
+			if c.Attributes.Name != key {
+				continue
+			}
+			if commandID != "" {
+				resp.Diagnostics.AddError("Ambiguous custom command name", fmt.Sprintf("More than one custom command on site %s is named %q; import it by ID instead.", siteID, key))
+				return
+			}
+			commandID = c.ID
+		}
+	}
+	if commandID == "" {
+		resp.Diagnostics.AddError("Custom command not found", fmt.Sprintf("No custom command with ID or name %q exists on site %s.", key, siteID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fmt.Sprintf("%s/%s", siteID, commandID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site_id"), siteID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("command_id"), commandID)...)
+}
+
+func (m *siteCustomCommandResourceModel) toAPIModel() client.CustomCommandAttributes {
+	attrs := client.CustomCommandAttributes{
+		Enabled:                m.Enabled.ValueBool(),
+		Name:                   m.Name.ValueString(),
+		Description:            m.Description.ValueString(),
+		Executable:             m.Executable.ValueString(),
+		Parameters:             m.Parameters.ValueString(),
+		LogFileName:            stringPointer(m.LogFile),
+		ProcessTimeOut:         int64Pointer(m.TimeoutSeconds),
+		RedirectOutputToClient: m.RedirectOutputToClient.ValueBool(),
+		MinNumOfParams:         int64Pointer(m.MinParameters),
+		MinNumOfParamsMsg:      stringPointer(m.MinParametersMessage),
+	}
+	attrs.RedirectOutputToLog = attrs.LogFileName != nil
+	attrs.UseProcessTimeOut = attrs.ProcessTimeOut != nil
+	attrs.UseMinNumOfParams = attrs.MinNumOfParams != nil
+	return attrs
+}
+
+// permissionsToAPI returns nil when the groups are not managed. Users already
+// allowed on current are carried over.
+func (m *siteCustomCommandResourceModel) permissionsToAPI(ctx context.Context, current *client.CustomCommand) (*client.CustomCommandRelationships, diag.Diagnostics) {
+	if m.PermissionGroupIDs.IsNull() || m.PermissionGroupIDs.IsUnknown() {
+		return nil, nil
+	}
+
+	var groupIDs []string
+	diags := m.PermissionGroupIDs.ElementsAs(ctx, &groupIDs, false)
+
+	permissions := client.CustomCommandPermissions{
+		Users:      []client.ResourceIdentifier{},
+		UserGroups: make([]client.ResourceIdentifier, 0, len(groupIDs)),
+	}
+	if current != nil && current.Relationships != nil {
+		for _, u := range current.Relationships.ExecutionPermissions.Data.Users {
+			permissions.Users = append(permissions.Users, client.ResourceIdentifier{Type: u.Type, ID: u.ID})
+		}
+	}
+	for _, id := range groupIDs {
+		permissions.UserGroups = append(permissions.UserGroups, client.ResourceIdentifier{Type: "userGroup", ID: id})
+	}
+
+	return &client.CustomCommandRelationships{
+		ExecutionPermissions: client.CustomCommandExecutionPermissions{Data: permissions},
+	}, diags
+}
+
+// fromAPI refreshes the model from EFT. Settings whose use* flag is off are
+// reported as unset, and permission groups are only refreshed when managed;
+// EFT lower-cases group GUIDs, so a set differing only in case is kept as
+// configured.
+func (m *siteCustomCommandResourceModel) fromAPI(ctx context.Context, command *client.CustomCommand) diag.Diagnostics {
+	var diags diag.Diagnostics
+	a := command.Attributes
+	m.CommandID = types.StringValue(command.ID)
+	m.Name = types.StringValue(a.Name)
+	m.Description = types.StringValue(a.Description)
+	m.Enabled = types.BoolValue(a.Enabled)
+	m.Executable = types.StringValue(a.Executable)
+	m.Parameters = types.StringValue(a.Parameters)
+	m.RedirectOutputToClient = types.BoolValue(a.RedirectOutputToClient)
+
+	m.LogFile = types.StringNull()
+	if a.RedirectOutputToLog {
+		m.LogFile = stringFromPointer(a.LogFileName)
+	}
+	m.TimeoutSeconds = types.Int64Null()
+	if a.UseProcessTimeOut {
+		m.TimeoutSeconds = int64FromPointer(a.ProcessTimeOut)
+	}
+	m.MinParameters = types.Int64Null()
+	m.MinParametersMessage = types.StringNull()
+	if a.UseMinNumOfParams {
+		m.MinParameters = int64FromPointer(a.MinNumOfParams)
+		if a.MinNumOfParamsMsg != nil && *a.MinNumOfParamsMsg != "" {
+			m.MinParametersMessage = types.StringValue(*a.MinNumOfParamsMsg)
+		}
+	}
+
+	if m.PermissionGroupIDs.IsNull() {
+		return diags
+	}
+
+	var groupIDs []string
+	if command.Relationships != nil {
+		for _, g := range command.Relationships.ExecutionPermissions.Data.UserGroups {
+			groupIDs = append(groupIDs, g.ID)
+		}
+	}
+
+	var prior []string
+	if !m.PermissionGroupIDs.IsUnknown() {
+		diags.Append(m.PermissionGroupIDs.ElementsAs(ctx, &prior, false)...)
+	}
+	if sameIDsIgnoringCase(prior, groupIDs) {
+		return diags
+	}
+
+	if groupIDs == nil {
+		groupIDs = []string{}
+	}
+	set, setDiags := types.SetValueFrom(ctx, types.StringType, groupIDs)
+	diags.Append(setDiags...)
+	m.PermissionGroupIDs = set
+	return diags
+}
+
+func sameIDsIgnoringCase(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	seen := make(map[string]int, len(a))
+	for _, id := range a {
+		seen[strings.ToLower(id)]++
+	}
+	for _, id := range b {
+		key := strings.ToLower(id)
+		if seen[key] == 0 {
+			return false
+		}
+		seen[key]--
+	}
+	return true
+}
@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSameIDsIgnoringCase(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []string
+		want bool
+	}{
+		{name: "both empty", a: nil, b: []string{}, want: true},
+		{name: "same order", a: []string{"a1", "b2"}, b: []string{"a1", "b2"}, want: true},
+		{name: "different case", a: []string{"AB-CD"}, b: []string{"ab-cd"}, want: true},
+		{name: "different order", a: []string{"a1", "B2"}, b: []string{"b2", "A1"}, want: true},
+		{name: "duplicates match", a: []string{"a1", "a1"}, b: []string{"A1", "a1"}, want: true},
+		{name: "duplicates differ", a: []string{"a1", "a1"}, b: []string{"a1", "b2"}, want: false},
+		{name: "different length", a: []string{"a1"}, b: []string{"a1", "b2"}, want: false},
+		{name: "different ids", a: []string{"a1"}, b: []string{"b2"}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sameIDsIgnoringCase(tt.a, tt.b); got != tt.want {
+				t.Errorf("sameIDsIgnoringCase(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSiteCustomCommandFromAPI(t *testing.T) {
+	ctx := context.Background()
+	logFile := "C:\\logs\\report.log"
+	timeout := int64(30)
+
+	command := &client.CustomCommand{
+		ID: "cmd-1",
+		Attributes: client.CustomCommandAttributes{
+			Name:                "report",
+			Executable:          "cscript.exe",
+			RedirectOutputToLog: true,
+			LogFileName:         &logFile,
+			// The stored timeout is ignored while the flag is off.
+			UseProcessTimeOut: false,
+			ProcessTimeOut:    &timeout,
+		},
+		Relationships: &client.CustomCommandRelationships{
+			ExecutionPermissions: client.CustomCommandExecutionPermissions{
+				Data: client.CustomCommandPermissions{
+					UserGroups: []client.ResourceIdentifier{{Type: "userGroup", ID: "9f3c-ab"}},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		prior      types.Set
+		wantGroups types.Set
+	}{
+		{
+			name:       "groups not managed",
+			prior:      types.SetNull(types.StringType),
+			wantGroups: types.SetNull(types.StringType),
+		},
+		{
+			name:       "configured case kept",
+			prior:      types.SetValueMust(types.StringType, []attr.Value{types.StringValue("9F3C-AB")}),
+			wantGroups: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("9F3C-AB")}),
+		},
+		{
+			name:       "drift reported",
+			prior:      types.SetValueMust(types.StringType, []attr.Value{types.StringValue("other")}),
+			wantGroups: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("9f3c-ab")}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := siteCustomCommandResourceModel{PermissionGroupIDs: tt.prior}
+			if diags := m.fromAPI(ctx, command); diags.HasError() {
+				t.Fatalf("fromAPI() diagnostics: %v", diags)
+			}
+			if m.LogFile.ValueString() != logFile {
+				t.Errorf("log_file = %s, want %s", m.LogFile, logFile)
+			}
+			if !m.TimeoutSeconds.IsNull() {
+				t.Errorf("timeout_seconds = %s, want null", m.TimeoutSeconds)
+			}
+			if !m.MinParameters.IsNull() || !m.MinParametersMessage.IsNull() {
+				t.Errorf("min parameters = %s/%s, want null", m.MinParameters, m.MinParametersMessage)
+			}
+			if !m.PermissionGroupIDs.Equal(tt.wantGroups) {
+				t.Errorf("permission_group_ids = %s, want %s", m.PermissionGroupIDs, tt.wantGroups)
+			}
+		})
+	}
+}
@@ -0,0 +1,281 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &siteDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &siteDataSource{}
+
+func NewSiteDataSource() datasource.DataSource {
+	return &siteDataSource{}
+}
+
+type siteDataSource struct {
+	client *client.Client
+}
+
+type siteDataSourceModel struct {
+	ID                   types.String          `tfsdk:"id"`
+	Name                 types.String          `tfsdk:"name"`
+	RootFolder           types.String          `tfsdk:"root_folder"`
+	AuthType             types.String          `tfsdk:"auth_type"`
+	LastModifiedBy       types.String          `tfsdk:"last_modified_by"`
+	LastModifiedTime     types.Int64           `tfsdk:"last_modified_time"`
+	ListenIPs            types.List            `tfsdk:"listen_ips"`
+	HTTPDomain           types.String          `tfsdk:"http_domain"`
+	AccountManagementURL types.String          `tfsdk:"account_management_url"`
+	FTPSExplicitPort     types.Int64           `tfsdk:"ftps_explicit_port"`
+	FTPSImplicitPort     types.Int64           `tfsdk:"ftps_implicit_port"`
+	HTTPSPort            types.Int64           `tfsdk:"https_port"`
+	SFTPPort             types.Int64           `tfsdk:"sftp_port"`
+	WorkspacesEnabled    types.Bool            `tfsdk:"workspaces_enabled"`
+	State                types.String          `tfsdk:"state"`
+	IsRunning            types.Bool            `tfsdk:"is_running"`
+	DMZGatewayConnected  types.Bool            `tfsdk:"dmz_gateway_connected"`
+	DefinedUserCount     types.Int64           `tfsdk:"defined_user_count"`
+	DisabledUserCount    types.Int64           `tfsdk:"disabled_user_count"`
+	Nodes                []siteNodeStatusModel `tfsdk:"nodes"`
+}
+
+type siteNodeStatusModel struct {
+	NodeName          types.String  `tfsdk:"node_name"`
+	State             types.String  `tfsdk:"state"`
+	DMZConnected      types.Bool    `tfsdk:"dmz_connected"`
+	StartTime         types.Int64   `tfsdk:"start_time"`
+	LastUserLoginTime types.Int64   `tfsdk:"last_user_login_time"`
+	Activity          activityModel `tfsdk:"activity"`
+}
+
+func (d *siteDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_site"
+}
+
+func (d *siteDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Look up a single Globalscape EFT site by `id` or `name` and return its settings, status and metrics.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Site identifier. Exactly one of `id` or `name` must be set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Exact site name. Exactly one of `id` or `name` must be set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"root_folder": schema.StringAttribute{
+				MarkdownDescription: "Site root folder.",
+				Computed:            true,
+			},
+			"auth_type": schema.StringAttribute{
+				MarkdownDescription: "User authentication provider (for example `EFT`).",
+				Computed:            true,
+			},
+			"last_modified_by": schema.StringAttribute{
+				MarkdownDescription: "Administrator that last changed the site.",
+				Computed:            true,
+			},
+			"last_modified_time": schema.Int64Attribute{
+				MarkdownDescription: "Unix time of the last change.",
+				Computed:            true,
+			},
+			"listen_ips": schema.ListAttribute{
+				MarkdownDescription: "IP addresses the site listeners bind to.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"http_domain": schema.StringAttribute{
+				MarkdownDescription: "Domain used for web client links.",
+				Computed:            true,
+			},
+			"account_management_url": schema.StringAttribute{
+				MarkdownDescription: "Account management URL sent to users.",
+				Computed:            true,
+			},
+			"ftps_explicit_port": schema.Int64Attribute{
+				MarkdownDescription: "FTP/explicit FTPS port.",
+				Computed:            true,
+			},
+			"ftps_implicit_port": schema.Int64Attribute{
+				MarkdownDescription: "Implicit FTPS port.",
+				Computed:            true,
+			},
+			"https_port": schema.Int64Attribute{
+				MarkdownDescription: "HTTPS port.",
+				Computed:            true,
+			},
+			"sftp_port": schema.Int64Attribute{
+				MarkdownDescription: "SFTP port.",
+				Computed:            true,
+			},
+			"workspaces_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether Workspaces is enabled on the site.",
+				Computed:            true,
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "Site state summarized across nodes, as in `globalscapeeft_sites`.",
+				Computed:            true,
+			},
+			"is_running": schema.BoolAttribute{
+				MarkdownDescription: "Whether the site is running, as reported by the metrics endpoint.",
+				Computed:            true,
+			},
+			"dmz_gateway_connected": schema.BoolAttribute{
+				MarkdownDescription: "Whether the site is connected to DMZ Gateway.",
+				Computed:            true,
+			},
+			"defined_user_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of users defined on the site.",
+				Computed:            true,
+			},
+			"disabled_user_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of disabled users.",
+				Computed:            true,
+			},
+			"nodes": schema.ListNestedAttribute{
+				MarkdownDescription: "Status of the site on each node. Null when the status endpoint is unavailable.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"node_name":            schema.StringAttribute{Computed: true},
+						"state":                schema.StringAttribute{Computed: true},
+						"dmz_connected":        schema.BoolAttribute{Computed: true},
+						"start_time":           schema.Int64Attribute{Computed: true},
+						"last_user_login_time": schema.Int64Attribute{Computed: true},
+						"activity": schema.SingleNestedAttribute{
+							MarkdownDescription: "Transfer and session counters for the site on the node.",
+							Computed:            true,
+							Attributes:          activitySchemaAttributes(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *siteDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *siteDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if c, ok := req.ProviderData.(*client.Client); ok {
+		d.client = c
+	}
+}
+
+func (d *siteDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var id, name types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name"), &name)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	siteID := stringValueOrEmpty(id)
+	if siteID == "" {
+		sites, err := d.client.ListSites(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to list sites", err.Error())
+			return
+		}
+		var matches []string
+		for _, s := range sites {
+			if s.Attributes.Name == name.ValueString() {
+				matches = append(matches, s.ID)
+			}
+		}
+		if len(matches) != 1 {
+			resp.Diagnostics.AddError("Unable to resolve site", fmt.Sprintf("expected exactly one site named %q, found %d", name.ValueString(), len(matches)))
+			return
+		}
+		siteID = matches[0]
+	}
+
+	site, err := d.client.GetSite(ctx, siteID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read site", err.Error())
+		return
+	}
+
+	metrics, err := d.client.GetSiteMetrics(ctx, siteID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read site metrics", err.Error())
+		return
+	}
+
+	statuses, statusDiags := lookupSiteStatuses(ctx, d.client)
+	resp.Diagnostics.Append(statusDiags...)
+	entries := statuses[siteID]
+
+	attrs := site.Attributes
+	listenIPs, diags := types.ListValueFrom(ctx, types.StringType, attrs.ListenerSettings.ListenIPs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := siteDataSourceModel{
+		ID:                   types.StringValue(site.ID),
+		Name:                 types.StringValue(attrs.Name),
+		RootFolder:           types.StringValue(attrs.General.RootFolder),
+		AuthType:             types.StringValue(attrs.General.AuthType),
+		LastModifiedBy:       types.StringValue(attrs.General.LastModifiedBy),
+		LastModifiedTime:     types.Int64Value(attrs.General.LastModifiedTime),
+		ListenIPs:            listenIPs,
+		HTTPDomain:           types.StringValue(attrs.ListenerSettings.HTTPDomain),
+		AccountManagementURL: types.StringValue(attrs.ListenerSettings.AccountManagementURL),
+		FTPSExplicitPort:     types.Int64Value(attrs.ListenerSettings.FTPS.ExplicitPort),
+		FTPSImplicitPort:     types.Int64Value(attrs.ListenerSettings.FTPS.ImplicitPort),
+		HTTPSPort:            types.Int64Value(attrs.ListenerSettings.HTTPS.Port),
+		SFTPPort:             types.Int64Value(attrs.ListenerSettings.SFTP.Port),
+		WorkspacesEnabled:    types.BoolValue(attrs.WorkspacesSettings.Enabled),
+		IsRunning:            types.BoolValue(metrics.Attributes.IsRunning),
+		DMZGatewayConnected:  types.BoolValue(metrics.Attributes.IsDMZGatewayConnected),
+		DefinedUserCount:     types.Int64Value(metrics.Attributes.DefinedUsers),
+		DisabledUserCount:    types.Int64Value(metrics.Attributes.DisabledUserCount),
+	}
+	data.State, _ = summarizeSiteStatus(entries)
+	// Leave nodes null when the status endpoint failed rather than claiming
+	// the site runs nowhere.
+	if statuses != nil {
+		data.Nodes = []siteNodeStatusModel{}
+	}
+
+	for _, e := range entries {
+		data.Nodes = append(data.Nodes, siteNodeStatusModel{
+			NodeName:          types.StringValue(e.NodeName),
+			State:             types.StringValue(e.Status.State),
+			DMZConnected:      types.BoolValue(e.Status.DMZConnected),
+			StartTime:         types.Int64Value(e.Status.StartTime),
+			LastUserLoginTime: types.Int64Value(e.Status.LastUserLoginTime),
+			Activity:          newActivityModel(e.Status.Activity),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,321 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &siteDropOffPortalResource{}
+var _ resource.ResourceWithConfigure = &siteDropOffPortalResource{}
+var _ resource.ResourceWithImportState = &siteDropOffPortalResource{}
+
+func NewSiteDropOffPortalResource() resource.Resource {
+	return &siteDropOffPortalResource{}
+}
+
+type siteDropOffPortalResource struct {
+	client *client.Client
+}
+
+type siteDropOffPortalResourceModel struct {
+	ID                        types.String `tfsdk:"id"`
+	SiteID                    types.String `tfsdk:"site_id"`
+	Enabled                   types.Bool   `tfsdk:"enabled"`
+	ReservedPath              types.String `tfsdk:"reserved_path"`
+	CaptchaType               types.String `tfsdk:"captcha_type"`
+	CaptchaSiteKey            types.String `tfsdk:"captcha_site_key"`
+	CaptchaSecretKey          types.String `tfsdk:"captcha_secret_key"`
+	LinkExpirationValue       types.Int64  `tfsdk:"link_expiration_value"`
+	LinkExpirationUnits       types.String `tfsdk:"link_expiration_units"`
+	MaxMessageSizeEnabled     types.Bool   `tfsdk:"max_message_size_enabled"`
+	MaxMessageSizeMB          types.Int64  `tfsdk:"max_message_size_mb"`
+	SendEntireMessageSecurely types.String `tfsdk:"send_entire_message_securely"`
+	AllowRecipientAddress     types.Bool   `tfsdk:"allow_recipient_address"`
+	AllowedDomainsEnabled     types.Bool   `tfsdk:"allowed_domains_enabled"`
+	AllowedDomains            types.List   `tfsdk:"allowed_domains"`
+	AddressBook               types.List   `tfsdk:"address_book"`
+}
+
+func (r *siteDropOffPortalResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_site_drop_off_portal"
+}
+
+func (r *siteDropOffPortalResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the Drop-Off portal (anonymous file drop-off) settings of a Globalscape EFT site. Only the configured attributes are sent to the API. Destroying this resource will only remove it from Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Site identifier.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site_id": schema.StringAttribute{
+				MarkdownDescription: "Site identifier whose Drop-Off portal is managed.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled":          optionalComputedBool("Whether anonymous users can drop off files."),
+			"reserved_path":    optionalComputedString("URL path of the Drop-Off portal, e.g. `/dropoff`.", stringvalidator.LengthAtLeast(1)),
+			"captcha_type":     optionalComputedString("CAPTCHA presented to anonymous senders, e.g. `reCAPTCHA`.", stringvalidator.LengthAtLeast(1)),
+			"captcha_site_key": optionalComputedString("CAPTCHA site key.", stringvalidator.LengthAtLeast(1)),
+			"captcha_secret_key": schema.StringAttribute{
+				MarkdownDescription: "CAPTCHA secret key. EFT returns it in clear text, so changes made outside Terraform are detected.",
+				Optional:            true,
+				Computed:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"link_expiration_value":        optionalComputedInt64("Lifetime of download links, in `link_expiration_units`.", int64validator.AtLeast(1)),
+			"link_expiration_units":        optionalComputedString("Unit of `link_expiration_value`: `Day`, `Week`, `Month` or `Year`.", stringvalidator.OneOf("Day", "Week", "Month", "Year")),
+			"max_message_size_enabled":     optionalComputedBool("Whether the size of a drop-off is limited."),
+			"max_message_size_mb":          optionalComputedInt64("Maximum size of a drop-off in MB.", int64validator.AtLeast(1)),
+			"send_entire_message_securely": optionalComputedString("Whether the message body is only available through the portal, e.g. `LetUserChoose`.", stringvalidator.LengthAtLeast(1)),
+			"allow_recipient_address":      optionalComputedBool("Whether senders can type a recipient address instead of choosing from `address_book`."),
+			"allowed_domains_enabled":      optionalComputedBool("Restrict typed recipient addresses to `allowed_domains`."),
+			"allowed_domains":              domainListAttribute("Email domain patterns typed recipient addresses must match, e.g. `*.example.com`."),
+			"address_book": schema.ListAttribute{
+				MarkdownDescription: "Recipients offered to senders, as `Name<address>`. An empty list clears the address book.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *siteDropOffPortalResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if c, ok := req.ProviderData.(*client.Client); ok {
+		r.client = c
+	}
+}
+
+func (r *siteDropOffPortalResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var plan siteDropOffPortalResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	update, diags := plan.toAPIModel(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.UpdateSiteDropOffPortal(ctx, plan.SiteID.ValueString(), update)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update site Drop-Off portal settings", err.Error())
+		return
+	}
+
+	newState, diags := fromSiteDropOffPortal(ctx, settings)
+	resp.Diagnostics.Append(diags...)
+	newState.ID = plan.SiteID
+	newState.SiteID = plan.SiteID
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *siteDropOffPortalResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var state siteDropOffPortalResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	siteID := state.SiteID
+	if siteID.IsNull() {
+		siteID = state.ID
+	}
+
+	settings, err := r.client.GetSiteDropOffPortal(ctx, siteID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read site Drop-Off portal settings", err.Error())
+		return
+	}
+
+	newState, diags := fromSiteDropOffPortal(ctx, settings)
+	resp.Diagnostics.Append(diags...)
+	newState.ID = siteID
+	newState.SiteID = siteID
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *siteDropOffPortalResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var plan siteDropOffPortalResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	update, diags := plan.toAPIModel(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.UpdateSiteDropOffPortal(ctx, plan.SiteID.ValueString(), update)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update site Drop-Off portal settings", err.Error())
+		return
+	}
+
+	newState, diags := fromSiteDropOffPortal(ctx, settings)
+	resp.Diagnostics.Append(diags...)
+	newState.ID = plan.ID
+	newState.SiteID = plan.SiteID
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *siteDropOffPortalResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The Drop-Off portal is part of the site and cannot be deleted via the API.
+	// Removing the resource from Terraform state only; the site keeps its configuration.
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *siteDropOffPortalResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site_id"), req.ID)...)
+}
+
+// toAPIModel only populates the fields that are known in the plan so the PATCH
+// body stays partial.
+func (m *siteDropOffPortalResourceModel) toAPIModel(ctx context.Context) (client.SiteDropOffPortal, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	settings := client.SiteDropOffPortal{
+		Enabled:                   boolPointer(m.Enabled),
+		ReservedPath:              stringPointer(m.ReservedPath),
+		LinkExpiration:            expirationPeriodToAPI(m.LinkExpirationValue, m.LinkExpirationUnits),
+		SendEntireMessageSecurely: stringPointer(m.SendEntireMessageSecurely),
+	}
+
+	captchaKeys := client.PortalCaptchaValue{
+		SiteKey:   stringPointer(m.CaptchaSiteKey),
+		SecretKey: stringPointer(m.CaptchaSecretKey),
+	}
+	captcha := client.PortalCaptcha{Type: stringPointer(m.CaptchaType)}
+	if captchaKeys.SiteKey != nil || captchaKeys.SecretKey != nil {
+		captcha.Value = &captchaKeys
+	}
+	if captcha.Type != nil || captcha.Value != nil {
+		settings.Captcha = &captcha
+	}
+
+	maxSize := client.MaxMessageSize{
+		Enabled: boolPointer(m.MaxMessageSizeEnabled),
+		MBytes:  int64Pointer(m.MaxMessageSizeMB),
+	}
+	if maxSize.Enabled != nil || maxSize.MBytes != nil {
+		settings.MaxMessageSize = &maxSize
+	}
+
+	recipient := client.DropOffRecipient{
+		AllowToEnterToEmailAddress: boolPointer(m.AllowRecipientAddress),
+		AllowedDomains:             domainFilterToAPI(ctx, m.AllowedDomainsEnabled, m.AllowedDomains, &diags),
+	}
+	if recipient.AllowToEnterToEmailAddress != nil || recipient.AllowedDomains != nil {
+		settings.UserEmailOption = &recipient
+	}
+
+	if !m.AddressBook.IsNull() && !m.AddressBook.IsUnknown() {
+		addressBook := []string{}
+		diags.Append(m.AddressBook.ElementsAs(ctx, &addressBook, false)...)
+		settings.AddressBookList = &addressBook
+	}
+
+	return settings, diags
+}
+
+func fromSiteDropOffPortal(ctx context.Context, settings *client.SiteDropOffPortal) (*siteDropOffPortalResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	m := &siteDropOffPortalResourceModel{
+		Enabled:                   boolFromPointer(settings.Enabled),
+		ReservedPath:              stringFromPointer(settings.ReservedPath),
+		SendEntireMessageSecurely: stringFromPointer(settings.SendEntireMessageSecurely),
+	}
+
+	captcha := settings.Captcha
+	if captcha == nil {
+		captcha = &client.PortalCaptcha{}
+	}
+	captchaKeys := captcha.Value
+	if captchaKeys == nil {
+		captchaKeys = &client.PortalCaptchaValue{}
+	}
+	m.CaptchaType = stringFromPointer(captcha.Type)
+	m.CaptchaSiteKey = stringFromPointer(captchaKeys.SiteKey)
+	m.CaptchaSecretKey = stringFromPointer(captchaKeys.SecretKey)
+
+	linkExpiration := settings.LinkExpiration
+	if linkExpiration == nil {
+		linkExpiration = &client.ExpirationPeriod{}
+	}
+	m.LinkExpirationValue = int64FromPointer(linkExpiration.Value)
+	m.LinkExpirationUnits = stringFromPointer(linkExpiration.Units)
+
+	maxSize := settings.MaxMessageSize
+	if maxSize == nil {
+		maxSize = &client.MaxMessageSize{}
+	}
+	m.MaxMessageSizeEnabled = boolFromPointer(maxSize.Enabled)
+	m.MaxMessageSizeMB = int64FromPointer(maxSize.MBytes)
+
+	recipient := settings.UserEmailOption
+	if recipient == nil {
+		recipient = &client.DropOffRecipient{}
+	}
+	m.AllowRecipientAddress = boolFromPointer(recipient.AllowToEnterToEmailAddress)
+	m.AllowedDomainsEnabled, m.AllowedDomains = domainFilterFromAPI(ctx, recipient.AllowedDomains, &diags)
+
+	addressBook := []string{}
+	if settings.AddressBookList != nil {
+		addressBook = *settings.AddressBookList
+	}
+	list, listDiags := types.ListValueFrom(ctx, types.StringType, addressBook)
+	diags.Append(listDiags...)
+	m.AddressBook = list
+
+	return m, diags
+}
@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSiteDropOffPortalToAPIModel(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		modify func(m *siteDropOffPortalResourceModel)
+		want   string
+	}{
+		{
+			name:   "nothing configured",
+			modify: func(m *siteDropOffPortalResourceModel) { m.AddressBook = types.ListUnknown(types.StringType) },
+			want:   `{}`,
+		},
+		{
+			name: "empty address book clears the list",
+			modify: func(m *siteDropOffPortalResourceModel) {
+				m.AddressBook = types.ListValueMust(types.StringType, []attr.Value{})
+			},
+			want: `{"addressBookList":[]}`,
+		},
+		{
+			name: "captcha keys nested under value",
+			modify: func(m *siteDropOffPortalResourceModel) {
+				m.AddressBook = types.ListNull(types.StringType)
+				m.CaptchaType = types.StringValue("reCaptchaV2")
+				m.CaptchaSiteKey = types.StringValue("site")
+				m.MaxMessageSizeMB = types.Int64Value(100)
+				m.AllowRecipientAddress = types.BoolValue(true)
+			},
+			want: `{"captcha":{"type":"reCaptchaV2","value":{"siteKey":"site"}},"maxMessageSize":{"mBytes":100},"userEmailOption":{"allowToEnterToEmailAddress":true}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := siteDropOffPortalResourceModel{AllowedDomains: types.ListNull(types.StringType)}
+			tt.modify(&m)
+
+			settings, diags := m.toAPIModel(ctx)
+			if diags.HasError() {
+				t.Fatalf("unexpected errors: %v", diags)
+			}
+			body, _ := json.Marshal(settings)
+			if string(body) != tt.want {
+				t.Errorf("toAPIModel() =\n%s\nwant\n%s", body, tt.want)
+			}
+		})
+	}
+}
+
+func TestFromSiteDropOffPortal(t *testing.T) {
+	var settings client.SiteDropOffPortal
+	body := `{"enabled":true,"captcha":{"type":"reCaptchaV2","value":{"siteKey":"site","secretKey":"secret"}},"userEmailOption":{"allowedDomains":{"enabled":true,"list":["example.com"]}}}`
+	if err := json.Unmarshal([]byte(body), &settings); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	m, diags := fromSiteDropOffPortal(context.Background(), &settings)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+	if m.CaptchaSiteKey.ValueString() != "site" || m.CaptchaSecretKey.ValueString() != "secret" {
+		t.Errorf("captcha = %s/%s", m.CaptchaSiteKey, m.CaptchaSecretKey)
+	}
+	if !m.AllowedDomainsEnabled.ValueBool() || len(m.AllowedDomains.Elements()) != 1 {
+		t.Errorf("allowed domains = %s %s", m.AllowedDomainsEnabled, m.AllowedDomains)
+	}
+	// A missing address book is an empty list, so removing every entry does
+	// not produce a perpetual diff.
+	if m.AddressBook.IsNull() || len(m.AddressBook.Elements()) != 0 {
+		t.Errorf("address book = %s, want an empty list", m.AddressBook)
+	}
+	if !m.MaxMessageSizeMB.IsNull() {
+		t.Errorf("max message size = %s, want null", m.MaxMessageSizeMB)
+	}
+}
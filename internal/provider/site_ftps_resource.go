@@ -0,0 +1,385 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &siteFTPSResource{}
+var _ resource.ResourceWithConfigure = &siteFTPSResource{}
+var _ resource.ResourceWithImportState = &siteFTPSResource{}
+var _ resource.ResourceWithValidateConfig = &siteFTPSResource{}
+
+func NewSiteFTPSResource() resource.Resource {
+	return &siteFTPSResource{}
+}
+
+type siteFTPSResource struct {
+	client *client.Client
+}
+
+type siteFTPSResourceModel struct {
+	ID                         types.String `tfsdk:"id"`
+	SiteID                     types.String `tfsdk:"site_id"`
+	FTPEnabled                 types.Bool   `tfsdk:"ftp_enabled"`
+	FTPPort                    types.Int64  `tfsdk:"ftp_port"`
+	ExplicitFTPSEnabled        types.Bool   `tfsdk:"explicit_ftps_enabled"`
+	ExplicitFTPSPort           types.Int64  `tfsdk:"explicit_ftps_port"`
+	ImplicitFTPSEnabled        types.Bool   `tfsdk:"implicit_ftps_enabled"`
+	ImplicitFTPSPort           types.Int64  `tfsdk:"implicit_ftps_port"`
+	EnableClearCommandChannel  types.Bool   `tfsdk:"enable_clear_command_channel"`
+	EnableFtpClientAntiTimeout types.Bool   `tfsdk:"enable_ftp_client_anti_timeout"`
+	PASVEnabled                types.Bool   `tfsdk:"pasv_enabled"`
+	PASVListenIP               types.String `tfsdk:"pasv_listen_ip"`
+	PASVPortMin                types.Int64  `tfsdk:"pasv_port_min"`
+	PASVPortMax                types.Int64  `tfsdk:"pasv_port_max"`
+	BannerMessage              types.String `tfsdk:"banner_message"`
+	BannerUsage                types.String `tfsdk:"banner_usage"`
+}
+
+func (r *siteFTPSResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_site_ftps"
+}
+
+func (r *siteFTPSResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the FTP and FTPS listeners of a Globalscape EFT site. Only the configured attributes are sent to the API. Destroying this resource will only remove it from Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Site identifier.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site_id": schema.StringAttribute{
+				MarkdownDescription: "Site identifier whose FTP/FTPS listeners are managed.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ftp_enabled":                    optionalComputedBool("Whether plain (unencrypted) FTP is accepted."),
+			"ftp_port":                       optionalComputedInt64("Port of the FTP listener. Shared with explicit FTPS.", int64validator.Between(1, 65535)),
+			"explicit_ftps_enabled":          optionalComputedBool("Whether explicit FTPS (AUTH TLS) is accepted."),
+			"explicit_ftps_port":             optionalComputedInt64("Port of the explicit FTPS listener. Must equal `ftp_port` when both are set.", int64validator.Between(1, 65535)),
+			"implicit_ftps_enabled":          optionalComputedBool("Whether implicit FTPS is accepted."),
+			"implicit_ftps_port":             optionalComputedInt64("Port of the implicit FTPS listener. Must differ from the FTP port.", int64validator.Between(1, 65535)),
+			"enable_clear_command_channel":   optionalComputedBool("Allow clients to revert the control channel to clear text (CCC) after authentication."),
+			"enable_ftp_client_anti_timeout": optionalComputedBool("Whether EFT's FTP client anti-timeout option is enabled."),
+			"pasv_enabled":                   optionalComputedBool("Whether passive mode data connections are restricted to the `pasv_port_min`-`pasv_port_max` range."),
+			"pasv_listen_ip": schema.StringAttribute{
+				MarkdownDescription: "IP address announced to clients for passive mode data connections, for example the public address behind NAT. `0.0.0.0` uses the listener address.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"pasv_port_min": optionalComputedInt64("First port of the passive mode range.", int64validator.Between(1, 65535)),
+			"pasv_port_max": optionalComputedInt64("Last port of the passive mode range. Must not be lower than `pasv_port_min`.", int64validator.Between(1, 65535)),
+			"banner_message": schema.StringAttribute{
+				MarkdownDescription: "Login banner shown to FTP clients. Requires `banner_usage = \"replaceDefault\"`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"banner_usage": schema.StringAttribute{
+				MarkdownDescription: "Which banner is shown: `useDefault` for the EFT banner or `replaceDefault` for `banner_message`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.FTPBannerUseDefault, client.FTPBannerReplaceDefault),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *siteFTPSResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data siteFTPSResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.validate()...)
+}
+
+// validate checks the rules EFT enforces between the listeners: plain FTP
+// and explicit FTPS share one listener and port, implicit FTPS needs its own
+// port, the passive range must be ordered and stay clear of the listener
+// ports, and the modes that exclude each other are not combined.
+func (m *siteFTPSResourceModel) validate() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	known := func(v types.Int64) bool { return !v.IsNull() && !v.IsUnknown() }
+	isFalse := func(v types.Bool) bool { return !v.IsNull() && !v.IsUnknown() && !v.ValueBool() }
+
+	if known(m.FTPPort) && known(m.ExplicitFTPSPort) && m.FTPPort.ValueInt64() != m.ExplicitFTPSPort.ValueInt64() {
+		diags.AddAttributeError(
+			path.Root("explicit_ftps_port"),
+			"Conflicting FTP ports",
+			fmt.Sprintf("Plain FTP and explicit FTPS share a listener, so explicit_ftps_port (%d) must equal ftp_port (%d).", m.ExplicitFTPSPort.ValueInt64(), m.FTPPort.ValueInt64()),
+		)
+	}
+
+	listenerPorts := []struct {
+		name  string
+		value types.Int64
+	}{{"ftp_port", m.FTPPort}, {"explicit_ftps_port", m.ExplicitFTPSPort}, {"implicit_ftps_port", m.ImplicitFTPSPort}}
+
+	if known(m.ImplicitFTPSPort) {
+		for _, p := range listenerPorts[:2] {
+			if known(p.value) && p.value.ValueInt64() == m.ImplicitFTPSPort.ValueInt64() {
+				diags.AddAttributeError(
+					path.Root("implicit_ftps_port"),
+					"Conflicting FTP ports",
+					fmt.Sprintf("implicit_ftps_port cannot use the same port as %s (%d).", p.name, p.value.ValueInt64()),
+				)
+			}
+		}
+	}
+
+	if known(m.PASVPortMin) && known(m.PASVPortMax) {
+		low, high := m.PASVPortMin.ValueInt64(), m.PASVPortMax.ValueInt64()
+		if low > high {
+			diags.AddAttributeError(
+				path.Root("pasv_port_max"),
+				"Invalid PASV port range",
+				fmt.Sprintf("pasv_port_max (%d) must not be lower than pasv_port_min (%d).", high, low),
+			)
+		} else {
+			for _, p := range listenerPorts {
+				if known(p.value) && p.value.ValueInt64() >= low && p.value.ValueInt64() <= high {
+					diags.AddAttributeError(
+						path.Root("pasv_port_min"),
+						"Conflicting FTP ports",
+						fmt.Sprintf("The PASV port range %d-%d includes %s (%d).", low, high, p.name, p.value.ValueInt64()),
+					)
+				}
+			}
+		}
+	}
+
+	// Clearing the command channel (CCC) is only possible on a TLS session.
+	if m.EnableClearCommandChannel.ValueBool() && isFalse(m.ExplicitFTPSEnabled) && isFalse(m.ImplicitFTPSEnabled) {
+		diags.AddAttributeError(
+			path.Root("enable_clear_command_channel"),
+			"Conflicting FTP modes",
+			"enable_clear_command_channel requires explicit or implicit FTPS to be enabled.",
+		)
+	}
+
+	// A custom banner message is ignored while EFT shows its default banner.
+	if !m.BannerUsage.IsUnknown() && m.BannerUsage.ValueString() == client.FTPBannerUseDefault && !m.BannerMessage.IsUnknown() && m.BannerMessage.ValueString() != "" {
+		diags.AddAttributeError(
+			path.Root("banner_message"),
+			"Conflicting FTP modes",
+			fmt.Sprintf("banner_message is only shown when banner_usage is %q.", client.FTPBannerReplaceDefault),
+		)
+	}
+
+	return diags
+}
+
+func (r *siteFTPSResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if c, ok := req.ProviderData.(*client.Client); ok {
+		r.client = c
+	}
+}
+
+func (r *siteFTPSResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var plan siteFTPSResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.UpdateSiteFTPS(ctx, plan.SiteID.ValueString(), plan.toAPIModel())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update site FTP settings", err.Error())
+		return
+	}
+
+	newState := fromSiteFTPS(settings)
+	newState.ID = plan.SiteID
+	newState.SiteID = plan.SiteID
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *siteFTPSResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var state siteFTPSResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	siteID := state.SiteID
+	if siteID.IsNull() {
+		siteID = state.ID
+	}
+
+	settings, err := r.client.GetSiteFTPS(ctx, siteID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read site FTP settings", err.Error())
+		return
+	}
+
+	newState := fromSiteFTPS(settings)
+	newState.ID = siteID
+	newState.SiteID = siteID
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *siteFTPSResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var plan siteFTPSResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.UpdateSiteFTPS(ctx, plan.SiteID.ValueString(), plan.toAPIModel())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update site FTP settings", err.Error())
+		return
+	}
+
+	newState := fromSiteFTPS(settings)
+	newState.ID = plan.ID
+	newState.SiteID = plan.SiteID
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *siteFTPSResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Listener settings are part of the site and cannot be deleted via the API.
+	// Removing the resource from Terraform state only; the listeners keep their configuration.
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *siteFTPSResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site_id"), req.ID)...)
+}
+
+func (m *siteFTPSResourceModel) toAPIModel() client.SiteFTPSSettings {
+	settings := client.SiteFTPSSettings{
+		FTP:          siteListenerToAPI(m.FTPEnabled, m.FTPPort),
+		ExplicitFTPS: siteListenerToAPI(m.ExplicitFTPSEnabled, m.ExplicitFTPSPort),
+		ImplicitFTPS: siteListenerToAPI(m.ImplicitFTPSEnabled, m.ImplicitFTPSPort),
+	}
+
+	cfg := client.FTPConfig{
+		EnableClearCommandChannel:  boolPointer(m.EnableClearCommandChannel),
+		EnableFtpClientAntiTimeout: boolPointer(m.EnableFtpClientAntiTimeout),
+	}
+	banner := client.FTPBanner{
+		Message: stringPointer(m.BannerMessage),
+		Usage:   stringPointer(m.BannerUsage),
+	}
+	if banner.Message != nil || banner.Usage != nil {
+		cfg.Banner = &banner
+	}
+	if cfg.EnableClearCommandChannel != nil || cfg.EnableFtpClientAntiTimeout != nil || cfg.Banner != nil {
+		settings.FTPConfig = &cfg
+	}
+
+	pasv := client.FTPPASV{
+		Enabled:  boolPointer(m.PASVEnabled),
+		ListenIP: stringPointer(m.PASVListenIP),
+		PortMin:  int64Pointer(m.PASVPortMin),
+		PortMax:  int64Pointer(m.PASVPortMax),
+	}
+	if pasv.Enabled != nil || pasv.ListenIP != nil || pasv.PortMin != nil || pasv.PortMax != nil {
+		settings.PASV = &pasv
+	}
+
+	return settings
+}
+
+func fromSiteFTPS(settings *client.SiteFTPSSettings) *siteFTPSResourceModel {
+	m := &siteFTPSResourceModel{}
+	m.FTPEnabled, m.FTPPort = siteListenerFromAPI(settings.FTP)
+	m.ExplicitFTPSEnabled, m.ExplicitFTPSPort = siteListenerFromAPI(settings.ExplicitFTPS)
+	m.ImplicitFTPSEnabled, m.ImplicitFTPSPort = siteListenerFromAPI(settings.ImplicitFTPS)
+
+	cfg := settings.FTPConfig
+	if cfg == nil {
+		cfg = &client.FTPConfig{}
+	}
+	m.EnableClearCommandChannel = boolFromPointer(cfg.EnableClearCommandChannel)
+	m.EnableFtpClientAntiTimeout = boolFromPointer(cfg.EnableFtpClientAntiTimeout)
+
+	banner := cfg.Banner
+	if banner == nil {
+		banner = &client.FTPBanner{}
+	}
+	m.BannerMessage = stringFromPointer(banner.Message)
+	m.BannerUsage = stringFromPointer(banner.Usage)
+
+	pasv := settings.PASV
+	if pasv == nil {
+		pasv = &client.FTPPASV{}
+	}
+	m.PASVEnabled = boolFromPointer(pasv.Enabled)
+	m.PASVListenIP = stringFromPointer(pasv.ListenIP)
+	m.PASVPortMin = int64FromPointer(pasv.PortMin)
+	m.PASVPortMax = int64FromPointer(pasv.PortMax)
+	return m
+}
+
+// siteListenerToAPI returns nil when neither value is configured so the
+// listener is left out of the PATCH body.
+func siteListenerToAPI(enabled types.Bool, port types.Int64) *client.SiteListener {
+	l := client.SiteListener{
+		Enabled: boolPointer(enabled),
+		Port:    int64Pointer(port),
+	}
+	if l.Enabled == nil && l.Port == nil {
+		return nil
+	}
+	return &l
+}
+
+func siteListenerFromAPI(l *client.SiteListener) (types.Bool, types.Int64) {
+	if l == nil {
+		return types.BoolNull(), types.Int64Null()
+	}
+	return boolFromPointer(l.Enabled), int64FromPointer(l.Port)
+}
@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// nullSiteFTPSModel returns a model with every attribute unset, as in an
+// empty configuration.
+func nullSiteFTPSModel() siteFTPSResourceModel {
+	return siteFTPSResourceModel{
+		FTPEnabled:                 types.BoolNull(),
+		FTPPort:                    types.Int64Null(),
+		ExplicitFTPSEnabled:        types.BoolNull(),
+		ExplicitFTPSPort:           types.Int64Null(),
+		ImplicitFTPSEnabled:        types.BoolNull(),
+		ImplicitFTPSPort:           types.Int64Null(),
+		EnableClearCommandChannel:  types.BoolNull(),
+		EnableFtpClientAntiTimeout: types.BoolNull(),
+		PASVEnabled:                types.BoolNull(),
+		PASVListenIP:               types.StringNull(),
+		PASVPortMin:                types.Int64Null(),
+		PASVPortMax:                types.Int64Null(),
+		BannerMessage:              types.StringNull(),
+		BannerUsage:                types.StringNull(),
+	}
+}
+
+func TestSiteFTPSValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(m *siteFTPSResourceModel)
+		wantErr string
+	}{
+		{name: "empty configuration", modify: func(*siteFTPSResourceModel) {}},
+		{
+			name: "valid listeners and range",
+			modify: func(m *siteFTPSResourceModel) {
+				m.FTPPort, m.ExplicitFTPSPort, m.ImplicitFTPSPort = types.Int64Value(21), types.Int64Value(21), types.Int64Value(990)
+				m.PASVPortMin, m.PASVPortMax = types.Int64Value(28000), types.Int64Value(30000)
+			},
+		},
+		{
+			name: "explicit port differs from ftp port",
+			modify: func(m *siteFTPSResourceModel) {
+				m.FTPPort, m.ExplicitFTPSPort = types.Int64Value(21), types.Int64Value(2121)
+			},
+			wantErr: "explicit_ftps_port (2121) must equal ftp_port (21)",
+		},
+		{
+			name: "implicit port shared with ftp",
+			modify: func(m *siteFTPSResourceModel) {
+				m.FTPPort, m.ImplicitFTPSPort = types.Int64Value(21), types.Int64Value(21)
+			},
+			wantErr: "implicit_ftps_port cannot use the same port as ftp_port",
+		},
+		{
+			name: "unknown ports are skipped",
+			modify: func(m *siteFTPSResourceModel) {
+				m.FTPPort, m.ExplicitFTPSPort = types.Int64Unknown(), types.Int64Value(2121)
+				m.PASVPortMin, m.PASVPortMax = types.Int64Value(30000), types.Int64Unknown()
+			},
+		},
+		{
+			name: "inverted pasv range",
+			modify: func(m *siteFTPSResourceModel) {
+				m.PASVPortMin, m.PASVPortMax = types.Int64Value(30000), types.Int64Value(28000)
+			},
+			wantErr: "pasv_port_max (28000) must not be lower than pasv_port_min (30000)",
+		},
+		{
+			name: "pasv range covers a listener",
+			modify: func(m *siteFTPSResourceModel) {
+				m.ImplicitFTPSPort = types.Int64Value(990)
+				m.PASVPortMin, m.PASVPortMax = types.Int64Value(900), types.Int64Value(1000)
+			},
+			wantErr: "The PASV port range 900-1000 includes implicit_ftps_port (990)",
+		},
+		{
+			name: "clear command channel without ftps",
+			modify: func(m *siteFTPSResourceModel) {
+				m.EnableClearCommandChannel = types.BoolValue(true)
+				m.ExplicitFTPSEnabled, m.ImplicitFTPSEnabled = types.BoolValue(false), types.BoolValue(false)
+			},
+			wantErr: "enable_clear_command_channel requires explicit or implicit FTPS",
+		},
+		{
+			name: "clear command channel with explicit ftps unset",
+			modify: func(m *siteFTPSResourceModel) {
+				m.EnableClearCommandChannel = types.BoolValue(true)
+				m.ImplicitFTPSEnabled = types.BoolValue(false)
+			},
+		},
+		{
+			name: "custom banner with default usage",
+			modify: func(m *siteFTPSResourceModel) {
+				m.BannerUsage, m.BannerMessage = types.StringValue(client.FTPBannerUseDefault), types.StringValue("Authorized use only.")
+			},
+			wantErr: `banner_message is only shown when banner_usage is "replaceDefault"`,
+		},
+		{
+			name: "custom banner replacing default",
+			modify: func(m *siteFTPSResourceModel) {
+				m.BannerUsage, m.BannerMessage = types.StringValue(client.FTPBannerReplaceDefault), types.StringValue("Authorized use only.")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := nullSiteFTPSModel()
+			tt.modify(&m)
+			diags := m.validate()
+
+			if tt.wantErr == "" {
+				if diags.HasError() {
+					t.Fatalf("unexpected errors: %v", diags)
+				}
+				return
+			}
+			if !diags.HasError() {
+				t.Fatalf("expected error containing %q", tt.wantErr)
+			}
+			if detail := diags.Errors()[0].Detail(); !strings.Contains(detail, tt.wantErr) {
+				t.Fatalf("error %q does not contain %q", detail, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSiteFTPSToAPIModel(t *testing.T) {
+	m := nullSiteFTPSModel()
+	m.FTPEnabled = types.BoolValue(false)
+	body, err := json.Marshal(m.toAPIModel())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(body), `{"ftp":{"enabled":false}}`; got != want {
+		t.Fatalf("unset sections must be omitted: got %s, want %s", got, want)
+	}
+
+	m.PASVEnabled = types.BoolValue(true)
+	m.PASVPortMin, m.PASVPortMax = types.Int64Value(28000), types.Int64Value(30000)
+	m.BannerUsage, m.BannerMessage = types.StringValue(client.FTPBannerReplaceDefault), types.StringValue("")
+	body, err = json.Marshal(m.toAPIModel())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"ftp":{"enabled":false},"ftpConfig":{"banner":{"message":"","usage":"replaceDefault"}},"pasv":{"enabled":true,"portMin":28000,"portMax":30000}}`
+	if string(body) != want {
+		t.Fatalf("got %s, want %s", body, want)
+	}
+}
+
+func TestFromSiteFTPS(t *testing.T) {
+	var settings client.SiteFTPSSettings
+	err := json.Unmarshal([]byte(`{
+		"ftp": {"enabled": false, "port": 21},
+		"explicitFtps": {"enabled": true, "port": 21},
+		"implicitFtps": {"enabled": true, "port": 990},
+		"ftpConfig": {"enableClearCommandChannel": false, "enableFtpClientAntiTimeout": true, "banner": {"message": "Login OK. Proceed.", "usage": "replaceDefault"}},
+		"pasv": {"enabled": false, "listenIP": "0.0.0.0", "portMin": 28000, "portMax": 30000}
+	}`), &settings)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := fromSiteFTPS(&settings)
+	if m.FTPEnabled.ValueBool() || m.ImplicitFTPSPort.ValueInt64() != 990 {
+		t.Fatalf("unexpected listeners %s/%s", m.FTPEnabled, m.ImplicitFTPSPort)
+	}
+	if m.PASVEnabled.ValueBool() || m.PASVListenIP.ValueString() != "0.0.0.0" || m.PASVPortMin.ValueInt64() != 28000 || m.PASVPortMax.ValueInt64() != 30000 {
+		t.Fatalf("unexpected pasv %s %s %s-%s", m.PASVEnabled, m.PASVListenIP, m.PASVPortMin, m.PASVPortMax)
+	}
+	if m.BannerMessage.ValueString() != "Login OK. Proceed." || m.BannerUsage.ValueString() != client.FTPBannerReplaceDefault {
+		t.Fatalf("unexpected banner %s/%s", m.BannerMessage, m.BannerUsage)
+	}
+
+	empty := fromSiteFTPS(&client.SiteFTPSSettings{})
+	if !empty.PASVPortMin.IsNull() || !empty.BannerUsage.IsNull() {
+		t.Fatalf("missing sections must be null, got %s/%s", empty.PASVPortMin, empty.BannerUsage)
+	}
+}
@@ -0,0 +1,237 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &siteGDPRResource{}
+var _ resource.ResourceWithConfigure = &siteGDPRResource{}
+var _ resource.ResourceWithImportState = &siteGDPRResource{}
+
+func NewSiteGDPRResource() resource.Resource {
+	return &siteGDPRResource{}
+}
+
+type siteGDPRResource struct {
+	client *client.Client
+}
+
+type siteGDPRResourceModel struct {
+	ID                          types.String `tfsdk:"id"`
+	SiteID                      types.String `tfsdk:"site_id"`
+	MaterialScope               types.String `tfsdk:"material_scope"`
+	TerritorialScope            types.String `tfsdk:"territorial_scope"`
+	ShowEUStatus                types.Bool   `tfsdk:"show_eu_status"`
+	AllowUsersToChangeEUSubject types.Bool   `tfsdk:"allow_users_to_change_eu_subject"`
+	ProcessingPrinciples        types.String `tfsdk:"processing_principles"`
+	LawfulnessOfProcessing      types.String `tfsdk:"lawfulness_of_processing"`
+	ConditionsForConsent        types.String `tfsdk:"conditions_for_consent"`
+	AgeRestrictions             types.String `tfsdk:"age_restrictions"`
+	TransparentInformation      types.String `tfsdk:"transparent_information"`
+	DirectCollection            types.String `tfsdk:"direct_collection"`
+	IndirectCollection          types.String `tfsdk:"indirect_collection"`
+	RightOfAccess               types.String `tfsdk:"right_of_access"`
+}
+
+func (r *siteGDPRResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_site_gdpr"
+}
+
+func (r *siteGDPRResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	article := func(description string) schema.StringAttribute {
+		return optionalComputedString(description, stringvalidator.LengthAtLeast(1))
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the GDPR settings of a Globalscape EFT site: the EU data subject options shown to users and the per-article compliance statements used by the GDPR report. Only the configured attributes are sent to the API. Destroying this resource will only remove it from Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Site identifier.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site_id": schema.StringAttribute{
+				MarkdownDescription: "Site identifier whose GDPR settings are managed.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"material_scope":                   article("Article 2 statement, e.g. `In scope`."),
+			"territorial_scope":                article("Article 3 statement, e.g. `In Union, all subjects in scope (3.1)`."),
+			"show_eu_status":                   optionalComputedBool("Show users whether they are an EU data subject."),
+			"allow_users_to_change_eu_subject": optionalComputedBool("Allow users to change their EU data subject status."),
+			"processing_principles":            article("Article 5 statement."),
+			"lawfulness_of_processing":         article("Article 6 statement, e.g. `Data subject consent (6.1.a)`."),
+			"conditions_for_consent":           article("Article 7 statement, e.g. `Set via EFT's ToS or Privacy Policy`."),
+			"age_restrictions":                 article("Article 8 statement."),
+			"transparent_information":          article("Article 12 statement."),
+			"direct_collection":                article("Article 13 statement."),
+			"indirect_collection":              article("Article 14 statement."),
+			"right_of_access":                  article("Article 15 statement."),
+		},
+	}
+}
+
+func (r *siteGDPRResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if c, ok := req.ProviderData.(*client.Client); ok {
+		r.client = c
+	}
+}
+
+func (r *siteGDPRResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var plan siteGDPRResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.UpdateSiteGDPR(ctx, plan.SiteID.ValueString(), plan.toAPIModel())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update site GDPR settings", err.Error())
+		return
+	}
+
+	newState := fromSiteGDPR(settings)
+	newState.ID = plan.SiteID
+	newState.SiteID = plan.SiteID
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *siteGDPRResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var state siteGDPRResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	siteID := state.SiteID
+	if siteID.IsNull() {
+		siteID = state.ID
+	}
+
+	settings, err := r.client.GetSiteGDPR(ctx, siteID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read site GDPR settings", err.Error())
+		return
+	}
+
+	newState := fromSiteGDPR(settings)
+	newState.ID = siteID
+	newState.SiteID = siteID
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *siteGDPRResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var plan siteGDPRResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.UpdateSiteGDPR(ctx, plan.SiteID.ValueString(), plan.toAPIModel())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update site GDPR settings", err.Error())
+		return
+	}
+
+	newState := fromSiteGDPR(settings)
+	newState.ID = plan.ID
+	newState.SiteID = plan.SiteID
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *siteGDPRResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// GDPR settings are part of the site and cannot be deleted via the API.
+	// Removing the resource from Terraform state only; the site keeps its configuration.
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *siteGDPRResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site_id"), req.ID)...)
+}
+
+func (m *siteGDPRResourceModel) toAPIModel() client.SiteGDPR {
+	settings := client.SiteGDPR{
+		MaterialScope:          stringPointer(m.MaterialScope),
+		ProcessingPrinciples:   stringPointer(m.ProcessingPrinciples),
+		LawfulnessOfProcessing: stringPointer(m.LawfulnessOfProcessing),
+		ConditionsForConsent:   stringPointer(m.ConditionsForConsent),
+		AgeRestrictions:        stringPointer(m.AgeRestrictions),
+		TransparentInformation: stringPointer(m.TransparentInformation),
+		DirectCollection:       stringPointer(m.DirectCollection),
+		IndirectCollection:     stringPointer(m.IndirectCollection),
+		RightOfAccess:          stringPointer(m.RightOfAccess),
+	}
+
+	value := client.GDPRTerritorialScopeValue{
+		ShowEUStatus:              boolPointer(m.ShowEUStatus),
+		AllUsersToChangeEUSubject: boolPointer(m.AllowUsersToChangeEUSubject),
+	}
+	scope := client.GDPRTerritorialScope{Type: stringPointer(m.TerritorialScope)}
+	if value.ShowEUStatus != nil || value.AllUsersToChangeEUSubject != nil {
+		scope.Value = &value
+	}
+	if scope.Type != nil || scope.Value != nil {
+		settings.TerritorialScope = &scope
+	}
+
+	return settings
+}
+
+func fromSiteGDPR(settings *client.SiteGDPR) *siteGDPRResourceModel {
+	scope := settings.TerritorialScope
+	if scope == nil {
+		scope = &client.GDPRTerritorialScope{}
+	}
+	value := scope.Value
+	if value == nil {
+		value = &client.GDPRTerritorialScopeValue{}
+	}
+
+	return &siteGDPRResourceModel{
+		MaterialScope:               stringFromPointer(settings.MaterialScope),
+		TerritorialScope:            stringFromPointer(scope.Type),
+		ShowEUStatus:                boolFromPointer(value.ShowEUStatus),
+		AllowUsersToChangeEUSubject: boolFromPointer(value.AllUsersToChangeEUSubject),
+		ProcessingPrinciples:        stringFromPointer(settings.ProcessingPrinciples),
+		LawfulnessOfProcessing:      stringFromPointer(settings.LawfulnessOfProcessing),
+		ConditionsForConsent:        stringFromPointer(settings.ConditionsForConsent),
+		AgeRestrictions:             stringFromPointer(settings.AgeRestrictions),
+		TransparentInformation:      stringFromPointer(settings.TransparentInformation),
+		DirectCollection:            stringFromPointer(settings.DirectCollection),
+		IndirectCollection:          stringFromPointer(settings.IndirectCollection),
+		RightOfAccess:               stringFromPointer(settings.RightOfAccess),
+	}
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSiteGDPRToAPIModel(t *testing.T) {
+	// Start from an empty read so every attribute is null, then set a few.
+	tests := []struct {
+		name   string
+		modify func(m *siteGDPRResourceModel)
+		want   string
+	}{
+		{name: "nothing set", modify: func(*siteGDPRResourceModel) {}, want: `{}`},
+		{
+			name:   "article status",
+			modify: func(m *siteGDPRResourceModel) { m.RightOfAccess = types.StringValue("In scope") },
+			want:   `{"rightOfAccess":"In scope"}`,
+		},
+		{
+			name:   "territorial scope type only",
+			modify: func(m *siteGDPRResourceModel) { m.TerritorialScope = types.StringValue("In scope") },
+			want:   `{"territorialScope":{"type":"In scope"}}`,
+		},
+		{
+			name: "territorial scope value only",
+			modify: func(m *siteGDPRResourceModel) {
+				m.ShowEUStatus = types.BoolValue(true)
+				m.AllowUsersToChangeEUSubject = types.BoolValue(false)
+			},
+			want: `{"territorialScope":{"value":{"showEUStatus":true,"allUsersToChangeEUSubject":false}}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := fromSiteGDPR(&client.SiteGDPR{})
+			tt.modify(m)
+			body, _ := json.Marshal(m.toAPIModel())
+			if string(body) != tt.want {
+				t.Errorf("toAPIModel() = %s, want %s", body, tt.want)
+			}
+		})
+	}
+}
+
+func TestFromSiteGDPR(t *testing.T) {
+	var settings client.SiteGDPR
+	body := `{"materialScope":"In scope","territorialScope":{"type":"Out of scope","value":{"showEUStatus":true}},"ageRestrictions":"Not applicable"}`
+	if err := json.Unmarshal([]byte(body), &settings); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	m := fromSiteGDPR(&settings)
+	if m.MaterialScope.ValueString() != "In scope" || m.AgeRestrictions.ValueString() != "Not applicable" {
+		t.Errorf("articles not read back: %+v", m)
+	}
+	if m.TerritorialScope.ValueString() != "Out of scope" || !m.ShowEUStatus.ValueBool() || !m.AllowUsersToChangeEUSubject.IsNull() {
+		t.Errorf("territorial scope = %s %s %s", m.TerritorialScope, m.ShowEUStatus, m.AllowUsersToChangeEUSubject)
+	}
+	if !m.RightOfAccess.IsNull() {
+		t.Errorf("right of access = %s, want null", m.RightOfAccess)
+	}
+}
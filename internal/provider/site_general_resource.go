@@ -0,0 +1,243 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &siteGeneralResource{}
+var _ resource.ResourceWithConfigure = &siteGeneralResource{}
+var _ resource.ResourceWithImportState = &siteGeneralResource{}
+
+func NewSiteGeneralResource() resource.Resource {
+	return &siteGeneralResource{}
+}
+
+type siteGeneralResource struct {
+	client *client.Client
+}
+
+type siteGeneralResourceModel struct {
+	ID                     types.String `tfsdk:"id"`
+	SiteID                 types.String `tfsdk:"site_id"`
+	RootFolder             types.String `tfsdk:"root_folder"`
+	OverrideVfsCredentials types.Bool   `tfsdk:"override_vfs_credentials"`
+	VfsLogin               types.String `tfsdk:"vfs_login"`
+	VfsPassword            types.String `tfsdk:"vfs_password"`
+}
+
+func (r *siteGeneralResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_site_general"
+}
+
+func (r *siteGeneralResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the general settings of a Globalscape EFT site (root folder and the credentials used to access it). Sites created with `globalscapeeft_site` manage their root folder there; set it in only one of the two resources. Note: general settings are part of the site and cannot be deleted. Destroying this resource will only remove it from Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Site identifier.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site_id": schema.StringAttribute{
+				MarkdownDescription: "Site identifier whose general settings are managed.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"root_folder": schema.StringAttribute{
+				MarkdownDescription: "Site root folder. Sites created with `globalscapeeft_site` already manage it there; set it in only one of the two resources.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"override_vfs_credentials": schema.BoolAttribute{
+				MarkdownDescription: "Access the site root folder with `vfs_login` instead of the EFT service account.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"vfs_login": schema.StringAttribute{
+				MarkdownDescription: "Account used to access the site root folder when `override_vfs_credentials` is enabled.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("override_vfs_credentials")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"vfs_password": schema.StringAttribute{
+				MarkdownDescription: "Password of `vfs_login`. EFT only returns an obfuscated value, so the configured password is kept in state as-is.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("override_vfs_credentials")),
+				},
+			},
+		},
+	}
+}
+
+func (r *siteGeneralResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if c, ok := req.ProviderData.(*client.Client); ok {
+		r.client = c
+	}
+}
+
+func (r *siteGeneralResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var plan siteGeneralResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	general, err := r.client.UpdateSiteGeneral(ctx, plan.SiteID.ValueString(), plan.toAPIModel())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update site general settings", err.Error())
+		return
+	}
+
+	newState := fromSiteGeneral(general)
+	newState.ID = plan.SiteID
+	newState.SiteID = plan.SiteID
+	newState.RootFolder = keepFolderSpelling(plan.RootFolder, newState.RootFolder)
+	newState.VfsPassword = plan.VfsPassword
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *siteGeneralResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var state siteGeneralResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	siteID := state.SiteID
+	if siteID.IsNull() {
+		siteID = state.ID
+	}
+
+	general, err := r.client.GetSiteGeneral(ctx, siteID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read site general settings", err.Error())
+		return
+	}
+
+	newState := fromSiteGeneral(general)
+	newState.ID = siteID
+	newState.SiteID = siteID
+	newState.RootFolder = keepFolderSpelling(state.RootFolder, newState.RootFolder)
+	newState.VfsPassword = state.VfsPassword
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *siteGeneralResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var plan siteGeneralResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	general, err := r.client.UpdateSiteGeneral(ctx, plan.SiteID.ValueString(), plan.toAPIModel())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update site general settings", err.Error())
+		return
+	}
+
+	newState := fromSiteGeneral(general)
+	newState.ID = plan.ID
+	newState.SiteID = plan.SiteID
+	newState.RootFolder = keepFolderSpelling(plan.RootFolder, newState.RootFolder)
+	newState.VfsPassword = plan.VfsPassword
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *siteGeneralResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// General settings are part of the site and cannot be deleted via the API.
+	// Removing the resource from Terraform state only; the settings remain on the site.
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *siteGeneralResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site_id"), req.ID)...)
+}
+
+func (m *siteGeneralResourceModel) toAPIModel() client.SiteGeneralUpdate {
+	update := client.SiteGeneralUpdate{}
+
+	if !m.RootFolder.IsNull() && !m.RootFolder.IsUnknown() {
+		v := m.RootFolder.ValueString()
+		update.SiteRootFolder = &v
+	}
+
+	// The credentials are sent as one object, so only include them when the
+	// toggle is configured.
+	if !m.OverrideVfsCredentials.IsNull() && !m.OverrideVfsCredentials.IsUnknown() {
+		update.OverrideVfsCredentials = &client.OverrideVfsCredentials{
+			Enabled: m.OverrideVfsCredentials.ValueBool(),
+			Value: client.VfsCredentials{
+				Login:    stringValueOrEmpty(m.VfsLogin),
+				Password: stringValueOrEmpty(m.VfsPassword),
+			},
+		}
+	}
+
+	return update
+}
+
+func fromSiteGeneral(general *client.SiteGeneralSettings) *siteGeneralResourceModel {
+	return &siteGeneralResourceModel{
+		RootFolder:             types.StringValue(general.Attributes.SiteRootFolder),
+		OverrideVfsCredentials: types.BoolValue(general.Attributes.OverrideVfsCredentials.Enabled),
+		VfsLogin:               types.StringValue(general.Attributes.OverrideVfsCredentials.Value.Login),
+		// vfs_password is preserved from the plan or prior state by the caller.
+	}
+}
+
+// keepFolderSpelling returns prior while it names the same folder as actual.
+// EFT normalizes the root folder, typically by appending a trailing backslash.
+func keepFolderSpelling(prior, actual types.String) types.String {
+	if prior.IsNull() || prior.IsUnknown() || !sameFolderPath(prior.ValueString(), actual.ValueString()) {
+		return actual
+	}
+	return prior
+}
@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSiteGeneralToAPIModel(t *testing.T) {
+	tests := []struct {
+		name       string
+		model      siteGeneralResourceModel
+		wantFolder string
+		wantCreds  *client.OverrideVfsCredentials
+	}{
+		{
+			name: "credentials not configured",
+			model: siteGeneralResourceModel{
+				RootFolder:             types.StringValue(`C:\InetPub\EFTRoot\MySite\`),
+				OverrideVfsCredentials: types.BoolNull(),
+			},
+			wantFolder: `C:\InetPub\EFTRoot\MySite\`,
+		},
+		{
+			name: "credentials enabled",
+			model: siteGeneralResourceModel{
+				RootFolder:             types.StringNull(),
+				OverrideVfsCredentials: types.BoolValue(true),
+				VfsLogin:               types.StringValue(`EXAMPLE\svc-eft-storage`),
+				VfsPassword:            types.StringValue("secret"),
+			},
+			wantCreds: &client.OverrideVfsCredentials{Enabled: true, Value: client.VfsCredentials{Login: `EXAMPLE\svc-eft-storage`, Password: "secret"}},
+		},
+		{
+			name: "credentials disabled",
+			model: siteGeneralResourceModel{
+				OverrideVfsCredentials: types.BoolValue(false),
+				VfsLogin:               types.StringNull(),
+				VfsPassword:            types.StringNull(),
+			},
+			wantCreds: &client.OverrideVfsCredentials{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.model.toAPIModel()
+			if tt.wantFolder == "" && got.SiteRootFolder != nil {
+				t.Fatalf("unset root folder must not be sent, got %q", *got.SiteRootFolder)
+			}
+			if tt.wantFolder != "" && (got.SiteRootFolder == nil || *got.SiteRootFolder != tt.wantFolder) {
+				t.Fatalf("root folder = %v, want %q", got.SiteRootFolder, tt.wantFolder)
+			}
+			if (got.OverrideVfsCredentials == nil) != (tt.wantCreds == nil) {
+				t.Fatalf("credentials = %+v, want %+v", got.OverrideVfsCredentials, tt.wantCreds)
+			}
+			if tt.wantCreds != nil && *got.OverrideVfsCredentials != *tt.wantCreds {
+				t.Fatalf("credentials = %+v, want %+v", *got.OverrideVfsCredentials, *tt.wantCreds)
+			}
+		})
+	}
+}
+
+func TestFromSiteGeneral(t *testing.T) {
+	general := &client.SiteGeneralSettings{Attributes: client.SiteGeneralSettingsAttributes{
+		SiteRootFolder: `C:\InetPub\EFTRoot\MySite\`,
+		OverrideVfsCredentials: client.OverrideVfsCredentials{
+			Enabled: true,
+			Value:   client.VfsCredentials{Login: `EXAMPLE\svc-eft-storage`, Password: "********"},
+		},
+	}}
+
+	got := fromSiteGeneral(general)
+	if got.RootFolder.ValueString() != `C:\InetPub\EFTRoot\MySite\` {
+		t.Fatalf("root_folder = %q", got.RootFolder.ValueString())
+	}
+	if !got.OverrideVfsCredentials.ValueBool() || got.VfsLogin.ValueString() != `EXAMPLE\svc-eft-storage` {
+		t.Fatalf("unexpected credentials %s/%s", got.OverrideVfsCredentials, got.VfsLogin)
+	}
+	if !got.VfsPassword.IsNull() {
+		t.Fatalf("the obfuscated password must not be stored, got %s", got.VfsPassword)
+	}
+}
+
+func TestKeepFolderSpelling(t *testing.T) {
+	actual := types.StringValue(`C:\InetPub\EFTRoot\MySite\`)
+
+	tests := []struct {
+		name  string
+		prior types.String
+		want  types.String
+	}{
+		{name: "not configured", prior: types.StringNull(), want: actual},
+		{name: "unknown", prior: types.StringUnknown(), want: actual},
+		{name: "equivalent spelling kept", prior: types.StringValue(`c:\inetpub\eftroot\mysite`), want: types.StringValue(`c:\inetpub\eftroot\mysite`)},
+		{name: "drift reported", prior: types.StringValue(`D:\EFT\MySite`), want: actual},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := keepFolderSpelling(tt.prior, actual); !got.Equal(tt.want) {
+				t.Fatalf("keepFolderSpelling() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
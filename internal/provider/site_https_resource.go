@@ -0,0 +1,332 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &siteHTTPSResource{}
+var _ resource.ResourceWithConfigure = &siteHTTPSResource{}
+var _ resource.ResourceWithImportState = &siteHTTPSResource{}
+var _ resource.ResourceWithValidateConfig = &siteHTTPSResource{}
+var _ resource.ResourceWithModifyPlan = &siteHTTPSResource{}
+
+func NewSiteHTTPSResource() resource.Resource {
+	return &siteHTTPSResource{}
+}
+
+type siteHTTPSResource struct {
+	client *client.Client
+}
+
+type siteHTTPSResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	SiteID              types.String `tfsdk:"site_id"`
+	HTTPEnabled         types.Bool   `tfsdk:"http_enabled"`
+	HTTPPort            types.Int64  `tfsdk:"http_port"`
+	HTTPSEnabled        types.Bool   `tfsdk:"https_enabled"`
+	HTTPSPort           types.Int64  `tfsdk:"https_port"`
+	Domain              types.String `tfsdk:"domain"`
+	RedirectHTTPToHTTPS types.Bool   `tfsdk:"redirect_http_to_https"`
+	EnableHsts          types.Bool   `tfsdk:"enable_hsts"`
+	EnableRestPage      types.Bool   `tfsdk:"enable_rest_page"`
+	AllowWebServices    types.Bool   `tfsdk:"allow_web_services"`
+	AS2Enabled          types.Bool   `tfsdk:"as2_enabled"`
+}
+
+func (r *siteHTTPSResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_site_https"
+}
+
+func (r *siteHTTPSResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the HTTP and HTTPS listeners of a Globalscape EFT site. Only the configured attributes are sent to the API. Destroying this resource will only remove it from Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Site identifier.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site_id": schema.StringAttribute{
+				MarkdownDescription: "Site identifier whose HTTP/HTTPS listeners are managed.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"http_enabled":  optionalComputedBool("Whether plain HTTP is accepted."),
+			"http_port":     optionalComputedInt64("Port of the HTTP listener.", int64validator.Between(1, 65535)),
+			"https_enabled": optionalComputedBool("Whether HTTPS is accepted."),
+			"https_port":    optionalComputedInt64("Port of the HTTPS listener.", int64validator.Between(1, 65535)),
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "Domain name used in links to the web transfer client.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"redirect_http_to_https": optionalComputedBool("Redirect HTTP requests to HTTPS."),
+			"enable_hsts":            optionalComputedBool("Send the HTTP Strict-Transport-Security header."),
+			"enable_rest_page":       optionalComputedBool("Whether the REST page is enabled."),
+			"allow_web_services":     optionalComputedBool("Whether web services are allowed over HTTP/HTTPS."),
+			"as2_enabled":            optionalComputedBool("Whether AS2 transfers over HTTP/HTTPS are enabled."),
+		},
+	}
+}
+
+func (r *siteHTTPSResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data siteHTTPSResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.HTTPPort.IsNull() && !data.HTTPPort.IsUnknown() &&
+		!data.HTTPSPort.IsNull() && !data.HTTPSPort.IsUnknown() &&
+		data.HTTPPort.ValueInt64() == data.HTTPSPort.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("https_port"),
+			"Conflicting HTTP ports",
+			fmt.Sprintf("http_port and https_port cannot both be %d.", data.HTTPSPort.ValueInt64()),
+		)
+	}
+}
+
+// ModifyPlan rejects listener ports that collide with the server's
+// administration port. EFT accepts such a change and then fails to bind one of
+// the listeners, which can lock administrators out of the REST API. The
+// server is only read when a listener port or toggle changes, so refreshing
+// an unchanged resource does not cost an extra request.
+func (r *siteHTTPSResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+	if req.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+
+	var plan siteHTTPSResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state *siteHTTPSResourceModel
+	if !req.State.Raw.IsNull() {
+		state = &siteHTTPSResourceModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	toCheck := changedListenerPorts(&plan, state)
+	if len(toCheck) == 0 {
+		return
+	}
+
+	server, err := r.client.GetServer(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read server", err.Error())
+		return
+	}
+
+	adminPort := server.Attributes.ListenerSettings.AdminPort
+	for _, l := range toCheck {
+		if l.port.ValueInt64() == adminPort {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(l.name),
+				"Port collides with the administration port",
+				fmt.Sprintf("%s %d is already used by the EFT administration interface and REST API.", l.name, adminPort),
+			)
+		}
+	}
+}
+
+type listenerPort struct {
+	name    string
+	enabled types.Bool
+	port    types.Int64
+}
+
+// changedListenerPorts returns the enabled listeners with a known port whose
+// port or toggle differs from state. Unchanged listeners were checked when
+// they were last planned.
+func changedListenerPorts(plan, state *siteHTTPSResourceModel) []listenerPort {
+	planned := []listenerPort{
+		{"http_port", plan.HTTPEnabled, plan.HTTPPort},
+		{"https_port", plan.HTTPSEnabled, plan.HTTPSPort},
+	}
+	var current []listenerPort
+	if state != nil {
+		current = []listenerPort{
+			{"http_port", state.HTTPEnabled, state.HTTPPort},
+			{"https_port", state.HTTPSEnabled, state.HTTPSPort},
+		}
+	}
+
+	var toCheck []listenerPort
+	for i, l := range planned {
+		// A disabled listener does not bind its port.
+		if l.enabled.Equal(types.BoolValue(false)) || l.port.IsNull() || l.port.IsUnknown() {
+			continue
+		}
+		if current != nil && l.port.Equal(current[i].port) && l.enabled.Equal(current[i].enabled) {
+			continue
+		}
+		toCheck = append(toCheck, l)
+	}
+	return toCheck
+}
+
+func (r *siteHTTPSResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if c, ok := req.ProviderData.(*client.Client); ok {
+		r.client = c
+	}
+}
+
+func (r *siteHTTPSResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var plan siteHTTPSResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.UpdateSiteHTTPS(ctx, plan.SiteID.ValueString(), plan.toAPIModel())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update site HTTP settings", err.Error())
+		return
+	}
+
+	newState := fromSiteHTTPS(settings)
+	newState.ID = plan.SiteID
+	newState.SiteID = plan.SiteID
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *siteHTTPSResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var state siteHTTPSResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	siteID := state.SiteID
+	if siteID.IsNull() {
+		siteID = state.ID
+	}
+
+	settings, err := r.client.GetSiteHTTPS(ctx, siteID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read site HTTP settings", err.Error())
+		return
+	}
+
+	newState := fromSiteHTTPS(settings)
+	newState.ID = siteID
+	newState.SiteID = siteID
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *siteHTTPSResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var plan siteHTTPSResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.UpdateSiteHTTPS(ctx, plan.SiteID.ValueString(), plan.toAPIModel())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update site HTTP settings", err.Error())
+		return
+	}
+
+	newState := fromSiteHTTPS(settings)
+	newState.ID = plan.ID
+	newState.SiteID = plan.SiteID
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *siteHTTPSResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Listener settings are part of the site and cannot be deleted via the API.
+	// Removing the resource from Terraform state only; the listeners keep their configuration.
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *siteHTTPSResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site_id"), req.ID)...)
+}
+
+func (m *siteHTTPSResourceModel) toAPIModel() client.SiteHTTPSSettings {
+	settings := client.SiteHTTPSSettings{
+		HTTP:                siteListenerToAPI(m.HTTPEnabled, m.HTTPPort),
+		HTTPS:               siteListenerToAPI(m.HTTPSEnabled, m.HTTPSPort),
+		RedirectHTTPToHTTPS: boolPointer(m.RedirectHTTPToHTTPS),
+		EnableHsts:          boolPointer(m.EnableHsts),
+		EnableRestPage:      boolPointer(m.EnableRestPage),
+		AllowWebServices:    boolPointer(m.AllowWebServices),
+	}
+
+	if !m.Domain.IsNull() && !m.Domain.IsUnknown() {
+		v := m.Domain.ValueString()
+		settings.Domain = &v
+	}
+	if v := boolPointer(m.AS2Enabled); v != nil {
+		settings.AS2 = &client.SiteAS2{Enabled: v}
+	}
+
+	return settings
+}
+
+func fromSiteHTTPS(settings *client.SiteHTTPSSettings) *siteHTTPSResourceModel {
+	m := &siteHTTPSResourceModel{
+		Domain:              types.StringNull(),
+		RedirectHTTPToHTTPS: boolFromPointer(settings.RedirectHTTPToHTTPS),
+		EnableHsts:          boolFromPointer(settings.EnableHsts),
+		EnableRestPage:      boolFromPointer(settings.EnableRestPage),
+		AllowWebServices:    boolFromPointer(settings.AllowWebServices),
+		AS2Enabled:          types.BoolNull(),
+	}
+	m.HTTPEnabled, m.HTTPPort = siteListenerFromAPI(settings.HTTP)
+	m.HTTPSEnabled, m.HTTPSPort = siteListenerFromAPI(settings.HTTPS)
+
+	if settings.Domain != nil {
+		m.Domain = types.StringValue(*settings.Domain)
+	}
+	if settings.AS2 != nil {
+		m.AS2Enabled = boolFromPointer(settings.AS2.Enabled)
+	}
+	return m
+}
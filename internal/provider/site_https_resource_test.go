@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestChangedListenerPorts(t *testing.T) {
+	model := func(httpEnabled types.Bool, httpPort int64, httpsEnabled types.Bool, httpsPort types.Int64) *siteHTTPSResourceModel {
+		return &siteHTTPSResourceModel{
+			HTTPEnabled:  httpEnabled,
+			HTTPPort:     types.Int64Value(httpPort),
+			HTTPSEnabled: httpsEnabled,
+			HTTPSPort:    httpsPort,
+		}
+	}
+	on, off := types.BoolValue(true), types.BoolValue(false)
+
+	tests := []struct {
+		name  string
+		plan  *siteHTTPSResourceModel
+		state *siteHTTPSResourceModel
+		want  []string
+	}{
+		{
+			name: "create checks enabled listeners",
+			plan: model(on, 80, on, types.Int64Value(443)),
+			want: []string{"http_port", "https_port"},
+		},
+		{
+			name: "create skips disabled and unknown ports",
+			plan: model(off, 80, on, types.Int64Unknown()),
+		},
+		{
+			name:  "unchanged listeners are not checked",
+			plan:  model(on, 80, on, types.Int64Value(443)),
+			state: model(on, 80, on, types.Int64Value(443)),
+		},
+		{
+			name:  "changed port is checked",
+			plan:  model(on, 80, on, types.Int64Value(8443)),
+			state: model(on, 80, on, types.Int64Value(443)),
+			want:  []string{"https_port"},
+		},
+		{
+			name:  "re-enabled listener is checked",
+			plan:  model(on, 80, on, types.Int64Value(443)),
+			state: model(off, 80, on, types.Int64Value(443)),
+			want:  []string{"http_port"},
+		},
+		{
+			name:  "unchanged port with unset toggle is not checked",
+			plan:  model(types.BoolNull(), 80, types.BoolNull(), types.Int64Null()),
+			state: model(types.BoolNull(), 80, types.BoolNull(), types.Int64Null()),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := changedListenerPorts(tt.plan, tt.state)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d listeners, want %v", len(got), tt.want)
+			}
+			for i, l := range got {
+				if l.name != tt.want[i] {
+					t.Fatalf("listener %d = %q, want %q", i, l.name, tt.want[i])
+				}
+			}
+		})
+	}
+}
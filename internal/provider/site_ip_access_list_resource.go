@@ -0,0 +1,398 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &siteIPAccessListResource{}
+var _ resource.ResourceWithConfigure = &siteIPAccessListResource{}
+var _ resource.ResourceWithImportState = &siteIPAccessListResource{}
+var _ resource.ResourceWithValidateConfig = &siteIPAccessListResource{}
+
+func NewSiteIPAccessListResource() resource.Resource {
+	return &siteIPAccessListResource{}
+}
+
+type siteIPAccessListResource struct {
+	client *client.Client
+}
+
+type siteIPAccessListResourceModel struct {
+	ID      types.String                 `tfsdk:"id"`
+	SiteID  types.String                 `tfsdk:"site_id"`
+	Entries []siteIPAccessListEntryModel `tfsdk:"entry"`
+}
+
+type siteIPAccessListEntryModel struct {
+	Address    types.String `tfsdk:"address"`
+	AccessType types.String `tfsdk:"access_type"`
+	Reason     types.String `tfsdk:"reason"`
+}
+
+func (r *siteIPAccessListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_site_ip_access_list"
+}
+
+func (r *siteIPAccessListResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Authoritatively manages the ordered IP access list of a Globalscape EFT site. Entries that are not configured are removed, except addresses banned automatically by EFT. Destroying this resource deletes the managed entries.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Site identifier.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site_id": schema.StringAttribute{
+				MarkdownDescription: "Site identifier whose IP access list is managed.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"entry": schema.ListNestedBlock{
+				MarkdownDescription: "Access list entries in evaluation order. The first entry receives sequence number 1.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"address": schema.StringAttribute{
+							MarkdownDescription: "IP address or wildcard mask, e.g. `192.168.100.*`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"access_type": schema.StringAttribute{
+							MarkdownDescription: "Either `Allow` or `Deny`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(client.IPAccessAllow, client.IPAccessDeny),
+							},
+						},
+						"reason": schema.StringAttribute{
+							MarkdownDescription: "Free-form note shown in the EFT administrator.",
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *siteIPAccessListResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data siteIPAccessListResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := make(map[string]int)
+	for i, e := range data.Entries {
+		if e.Address.IsNull() || e.Address.IsUnknown() {
+			continue
+		}
+		address := e.Address.ValueString()
+		if first, ok := seen[address]; ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("entry").AtListIndex(i).AtName("address"),
+				"Duplicate IP access entry",
+				fmt.Sprintf("Address %q is already used by entry %d.", address, first),
+			)
+			continue
+		}
+		seen[address] = i
+	}
+}
+
+func (r *siteIPAccessListResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if c, ok := req.ProviderData.(*client.Client); ok {
+		r.client = c
+	}
+}
+
+func (r *siteIPAccessListResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var plan siteIPAccessListResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.reconcile(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.SiteID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *siteIPAccessListResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var state siteIPAccessListResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.SiteID.IsNull() {
+		state.SiteID = state.ID
+	}
+
+	entries, err := r.client.ListSiteIPAccess(ctx, state.SiteID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read IP access list", err.Error())
+		return
+	}
+
+	state.fromAPI(entries)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *siteIPAccessListResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var plan siteIPAccessListResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.reconcile(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *siteIPAccessListResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var state siteIPAccessListResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	siteID := state.SiteID.ValueString()
+	current, err := r.client.ListSiteIPAccess(ctx, siteID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read IP access list", err.Error())
+		return
+	}
+
+	for _, address := range existingIPAccessAddresses(current, state.Entries) {
+		if err := r.client.DeleteSiteIPAccess(ctx, siteID, address); err != nil {
+			resp.Diagnostics.AddError("Failed to delete IP access entry", err.Error())
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *siteIPAccessListResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site_id"), req.ID)...)
+}
+
+// reconcile deletes unmanaged entries, then creates or patches the planned
+// entries in order so each lands on its sequence number, and finally stores
+// the resulting list in the model. The list is read once and only read again
+// after a change that makes EFT renumber the entries: a delete, a create or a
+// move to another sequence number.
+func (r *siteIPAccessListResource) reconcile(ctx context.Context, plan *siteIPAccessListResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	siteID := plan.SiteID.ValueString()
+
+	current, err := r.client.ListSiteIPAccess(ctx, siteID)
+	if err != nil {
+		diags.AddError("Failed to read IP access list", err.Error())
+		return diags
+	}
+
+	unmanaged := unmanagedIPAccessEntries(current, plan.Entries)
+	for _, address := range unmanaged {
+		if err := r.client.DeleteSiteIPAccess(ctx, siteID, address); err != nil {
+			diags.AddError("Failed to delete IP access entry", err.Error())
+			return diags
+		}
+	}
+	if len(unmanaged) > 0 {
+		if current, err = r.client.ListSiteIPAccess(ctx, siteID); err != nil {
+			diags.AddError("Failed to read IP access list", err.Error())
+			return diags
+		}
+	}
+
+	for i, e := range plan.Entries {
+		address := e.Address.ValueString()
+		update := client.IPAccessUpdate{
+			AccessType:     e.AccessType.ValueString(),
+			Reason:         stringValueOrEmpty(e.Reason),
+			SequenceNumber: int64(i + 1),
+		}
+
+		change, index := ipAccessChangeFor(current, address, update)
+		var entry *client.IPAccessEntry
+		switch change {
+		case ipAccessUnchanged:
+			continue
+		case ipAccessCreate:
+			entry, err = r.client.CreateSiteIPAccess(ctx, siteID, address, update)
+		default:
+			entry, err = r.client.UpdateSiteIPAccess(ctx, siteID, address, update)
+		}
+		if err != nil {
+			diags.AddError(fmt.Sprintf("Failed to apply IP access entry %q", address), err.Error())
+			return diags
+		}
+
+		if change == ipAccessEdit {
+			current[index] = *entry
+			continue
+		}
+		if current, err = r.client.ListSiteIPAccess(ctx, siteID); err != nil {
+			diags.AddError("Failed to read IP access list", err.Error())
+			return diags
+		}
+	}
+
+	plan.fromAPI(current)
+	return diags
+}
+
+type ipAccessChange int
+
+const (
+	ipAccessUnchanged ipAccessChange = iota
+	ipAccessCreate
+	// ipAccessEdit changes the access type or reason in place.
+	ipAccessEdit
+	// ipAccessMove changes the sequence number, which renumbers the list.
+	ipAccessMove
+)
+
+// unmanagedIPAccessEntries returns the addresses in current that are not
+// planned. Auto-ban entries are owned by EFT and never returned.
+func unmanagedIPAccessEntries(current []client.IPAccessEntry, planned []siteIPAccessListEntryModel) []string {
+	desired := make(map[string]bool, len(planned))
+	for _, e := range planned {
+		desired[e.Address.ValueString()] = true
+	}
+
+	var unmanaged []string
+	for _, e := range current {
+		if desired[e.ID] || e.Attributes.IsAutoBan {
+			continue
+		}
+		unmanaged = append(unmanaged, e.ID)
+	}
+	return unmanaged
+}
+
+// existingIPAccessAddresses returns the managed addresses still present in
+// current. Entries removed outside Terraform are skipped, as are addresses
+// EFT has since banned automatically.
+func existingIPAccessAddresses(current []client.IPAccessEntry, managed []siteIPAccessListEntryModel) []string {
+	existing := make(map[string]bool, len(current))
+	for _, e := range current {
+		if !e.Attributes.IsAutoBan {
+			existing[e.ID] = true
+		}
+	}
+
+	var addresses []string
+	for _, e := range managed {
+		if existing[e.Address.ValueString()] {
+			addresses = append(addresses, e.Address.ValueString())
+		}
+	}
+	return addresses
+}
+
+// ipAccessChangeFor compares a planned entry with the current list and
+// returns the change needed and the index of the existing entry.
+func ipAccessChangeFor(current []client.IPAccessEntry, address string, update client.IPAccessUpdate) (ipAccessChange, int) {
+	for i, e := range current {
+		if e.ID != address {
+			continue
+		}
+		cur := e.Attributes
+		switch {
+		case cur.SequenceNumber != update.SequenceNumber:
+			return ipAccessMove, i
+		// A manual entry replaces an automatic ban on the same address.
+		case cur.AccessType != update.AccessType || cur.Reason != update.Reason || cur.IsAutoBan:
+			return ipAccessEdit, i
+		default:
+			return ipAccessUnchanged, i
+		}
+	}
+	return ipAccessCreate, -1
+}
+
+// fromAPI keeps the manually configured entries ordered by sequence number.
+// Auto-ban entries are owned by EFT and never appear in state.
+func (m *siteIPAccessListResourceModel) fromAPI(entries []client.IPAccessEntry) {
+	managed := make([]client.IPAccessEntry, 0, len(entries))
+	for _, e := range entries {
+		if !e.Attributes.IsAutoBan {
+			managed = append(managed, e)
+		}
+	}
+	sort.SliceStable(managed, func(i, j int) bool {
+		return managed[i].Attributes.SequenceNumber < managed[j].Attributes.SequenceNumber
+	})
+
+	m.Entries = make([]siteIPAccessListEntryModel, 0, len(managed))
+	for _, e := range managed {
+		reason := types.StringNull()
+		if e.Attributes.Reason != "" {
+			reason = types.StringValue(e.Attributes.Reason)
+		}
+		m.Entries = append(m.Entries, siteIPAccessListEntryModel{
+			Address:    types.StringValue(e.ID),
+			AccessType: types.StringValue(e.Attributes.AccessType),
+			Reason:     reason,
+		})
+	}
+}
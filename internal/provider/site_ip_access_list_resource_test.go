@@ -0,0 +1,341 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+)
+
+func TestUnmanagedIPAccessEntries(t *testing.T) {
+	current := []client.IPAccessEntry{
+		{ID: "10.0.0.1", Attributes: client.IPAccessAttributes{SequenceNumber: 1}},
+		{ID: "10.0.0.2", Attributes: client.IPAccessAttributes{SequenceNumber: 2, IsAutoBan: true}},
+		{ID: "10.0.0.3", Attributes: client.IPAccessAttributes{SequenceNumber: 3}},
+	}
+
+	tests := []struct {
+		name    string
+		planned []string
+		want    []string
+	}{
+		{name: "nothing planned keeps auto-bans", want: []string{"10.0.0.1", "10.0.0.3"}},
+		{name: "planned entries kept", planned: []string{"10.0.0.3"}, want: []string{"10.0.0.1"}},
+		{name: "all planned", planned: []string{"10.0.0.1", "10.0.0.3"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var planned []siteIPAccessListEntryModel
+			for _, address := range tt.planned {
+				planned = append(planned, siteIPAccessListEntryModel{Address: types.StringValue(address)})
+			}
+			if got := unmanagedIPAccessEntries(current, planned); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("unmanagedIPAccessEntries() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIPAccessChangeFor(t *testing.T) {
+	current := []client.IPAccessEntry{
+		{ID: "10.0.0.1", Attributes: client.IPAccessAttributes{AccessType: client.IPAccessDeny, Reason: "scanner", SequenceNumber: 1}},
+		{ID: "10.0.0.2", Attributes: client.IPAccessAttributes{AccessType: client.IPAccessDeny, SequenceNumber: 2, IsAutoBan: true}},
+	}
+
+	tests := []struct {
+		name      string
+		address   string
+		update    client.IPAccessUpdate
+		want      ipAccessChange
+		wantIndex int
+	}{
+		{
+			name:      "missing address created",
+			address:   "10.0.0.9",
+			update:    client.IPAccessUpdate{AccessType: client.IPAccessAllow, SequenceNumber: 1},
+			want:      ipAccessCreate,
+			wantIndex: -1,
+		},
+		{
+			name:    "identical entry unchanged",
+			address: "10.0.0.1",
+			update:  client.IPAccessUpdate{AccessType: client.IPAccessDeny, Reason: "scanner", SequenceNumber: 1},
+			want:    ipAccessUnchanged,
+		},
+		{
+			name:    "reason edited in place",
+			address: "10.0.0.1",
+			update:  client.IPAccessUpdate{AccessType: client.IPAccessDeny, SequenceNumber: 1},
+			want:    ipAccessEdit,
+		},
+		{
+			name:    "access type edited in place",
+			address: "10.0.0.1",
+			update:  client.IPAccessUpdate{AccessType: client.IPAccessAllow, Reason: "scanner", SequenceNumber: 1},
+			want:    ipAccessEdit,
+		},
+		{
+			name:      "auto-ban replaced by manual entry",
+			address:   "10.0.0.2",
+			update:    client.IPAccessUpdate{AccessType: client.IPAccessDeny, SequenceNumber: 2},
+			want:      ipAccessEdit,
+			wantIndex: 1,
+		},
+		{
+			name:      "new sequence number moves",
+			address:   "10.0.0.2",
+			update:    client.IPAccessUpdate{AccessType: client.IPAccessDeny, SequenceNumber: 1},
+			want:      ipAccessMove,
+			wantIndex: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, index := ipAccessChangeFor(current, tt.address, tt.update)
+			if got != tt.want || index != tt.wantIndex {
+				t.Errorf("ipAccessChangeFor() = (%d, %d), want (%d, %d)", got, index, tt.want, tt.wantIndex)
+			}
+		})
+	}
+}
+
+// fakeIPAccessList serves an in-memory site IP access list that renumbers its
+// entries after every insert, delete or move, as EFT does.
+type fakeIPAccessList struct {
+	mu      sync.Mutex
+	entries []client.IPAccessEntry
+	lists   int
+	calls   []string
+}
+
+func (f *fakeIPAccessList) register(mux *http.ServeMux) {
+	mux.HandleFunc("GET /admin/v2/sites/site-1/ipAccessList", func(w http.ResponseWriter, _ *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		f.lists++
+		body, _ := json.Marshal(map[string]any{"data": f.entries})
+		writeTestJSON(w, http.StatusOK, string(body))
+	})
+	mux.HandleFunc("POST /admin/v2/sites/site-1/ipAccessList", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		var req struct {
+			Data struct {
+				ID         string                `json:"id"`
+				Attributes client.IPAccessUpdate `json:"attributes"`
+			} `json:"data"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		f.calls = append(f.calls, "create "+req.Data.ID)
+		entry := client.IPAccessEntry{Type: "access", ID: req.Data.ID, Attributes: client.IPAccessAttributes{
+			AccessType: req.Data.Attributes.AccessType,
+			Reason:     req.Data.Attributes.Reason,
+		}}
+		f.insert(entry, req.Data.Attributes.SequenceNumber)
+		f.writeEntry(w, req.Data.ID)
+	})
+	mux.HandleFunc("PATCH /admin/v2/sites/site-1/ipAccessList/{address}", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		var req struct {
+			Data struct {
+				Attributes client.IPAccessUpdate `json:"attributes"`
+			} `json:"data"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		address := r.PathValue("address")
+		f.calls = append(f.calls, "update "+address)
+		i := f.index(address)
+		if i < 0 {
+			writeTestJSON(w, http.StatusNotFound, `{}`)
+			return
+		}
+		entry := f.entries[i]
+		entry.Attributes.AccessType = req.Data.Attributes.AccessType
+		entry.Attributes.Reason = req.Data.Attributes.Reason
+		entry.Attributes.IsAutoBan = false
+		f.entries = slices.Delete(f.entries, i, i+1)
+		f.insert(entry, req.Data.Attributes.SequenceNumber)
+		f.writeEntry(w, address)
+	})
+	mux.HandleFunc("DELETE /admin/v2/sites/site-1/ipAccessList/{address}", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		address := r.PathValue("address")
+		f.calls = append(f.calls, "delete "+address)
+		i := f.index(address)
+		if i < 0 {
+			writeTestJSON(w, http.StatusNotFound, `{}`)
+			return
+		}
+		f.entries = slices.Delete(f.entries, i, i+1)
+		f.renumber()
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func (f *fakeIPAccessList) index(address string) int {
+	return slices.IndexFunc(f.entries, func(e client.IPAccessEntry) bool { return e.ID == address })
+}
+
+func (f *fakeIPAccessList) insert(entry client.IPAccessEntry, sequence int64) {
+	at := len(f.entries)
+	if sequence > 0 && int(sequence) <= len(f.entries) {
+		at = int(sequence) - 1
+	}
+	f.entries = slices.Insert(f.entries, at, entry)
+	f.renumber()
+}
+
+func (f *fakeIPAccessList) renumber() {
+	for i := range f.entries {
+		f.entries[i].Attributes.SequenceNumber = int64(i + 1)
+	}
+}
+
+func (f *fakeIPAccessList) writeEntry(w http.ResponseWriter, address string) {
+	body, _ := json.Marshal(map[string]any{"data": f.entries[f.index(address)]})
+	writeTestJSON(w, http.StatusOK, string(body))
+}
+
+func ipAccessEntries(specs ...string) []client.IPAccessEntry {
+	var entries []client.IPAccessEntry
+	for i, spec := range specs {
+		address, accessType, _ := strings.Cut(spec, "=")
+		autoBan := strings.HasSuffix(accessType, "!")
+		entries = append(entries, client.IPAccessEntry{Type: "access", ID: address, Attributes: client.IPAccessAttributes{
+			AccessType:     strings.TrimSuffix(accessType, "!"),
+			IsAutoBan:      autoBan,
+			SequenceNumber: int64(i + 1),
+		}})
+	}
+	return entries
+}
+
+func ipAccessEntryModels(specs ...string) []siteIPAccessListEntryModel {
+	var entries []siteIPAccessListEntryModel
+	for _, spec := range specs {
+		address, accessType, _ := strings.Cut(spec, "=")
+		entries = append(entries, siteIPAccessListEntryModel{
+			Address:    types.StringValue(address),
+			AccessType: types.StringValue(accessType),
+			Reason:     types.StringNull(),
+		})
+	}
+	return entries
+}
+
+func TestSiteIPAccessListReconcile(t *testing.T) {
+	tests := []struct {
+		name      string
+		current   []client.IPAccessEntry
+		planned   []siteIPAccessListEntryModel
+		wantList  []string
+		wantCalls []string
+		wantLists int
+	}{
+		{
+			name:      "in sync lists once",
+			current:   ipAccessEntries("10.0.0.1=Allow", "10.0.0.2=Deny"),
+			planned:   ipAccessEntryModels("10.0.0.1=Allow", "10.0.0.2=Deny"),
+			wantList:  []string{"10.0.0.1", "10.0.0.2"},
+			wantLists: 1,
+		},
+		{
+			name:      "in place edit does not re-list",
+			current:   ipAccessEntries("10.0.0.1=Allow", "10.0.0.2=Deny"),
+			planned:   ipAccessEntryModels("10.0.0.1=Deny", "10.0.0.2=Deny"),
+			wantList:  []string{"10.0.0.1", "10.0.0.2"},
+			wantCalls: []string{"update 10.0.0.1"},
+			wantLists: 1,
+		},
+		{
+			name:      "unmanaged deleted and auto-ban kept",
+			current:   ipAccessEntries("10.0.0.9=Deny", "10.0.0.1=Allow", "10.0.0.5=Deny!"),
+			planned:   ipAccessEntryModels("10.0.0.1=Allow"),
+			wantList:  []string{"10.0.0.1", "10.0.0.5"},
+			wantCalls: []string{"delete 10.0.0.9"},
+			wantLists: 2,
+		},
+		{
+			name:      "create and reorder",
+			current:   ipAccessEntries("10.0.0.2=Deny", "10.0.0.1=Allow"),
+			planned:   ipAccessEntryModels("10.0.0.3=Deny", "10.0.0.1=Allow", "10.0.0.2=Deny"),
+			wantList:  []string{"10.0.0.3", "10.0.0.1", "10.0.0.2"},
+			wantCalls: []string{"create 10.0.0.3", "update 10.0.0.1"},
+			wantLists: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeIPAccessList{entries: tt.current}
+			mux := http.NewServeMux()
+			fake.register(mux)
+			r := &siteIPAccessListResource{client: newTestClient(t, mux)}
+
+			plan := siteIPAccessListResourceModel{SiteID: types.StringValue("site-1"), Entries: tt.planned}
+			if diags := r.reconcile(context.Background(), &plan); diags.HasError() {
+				t.Fatalf("reconcile() diagnostics: %v", diags)
+			}
+
+			var got []string
+			for _, e := range fake.entries {
+				got = append(got, e.ID)
+			}
+			if !reflect.DeepEqual(got, tt.wantList) {
+				t.Errorf("server list = %v, want %v", got, tt.wantList)
+			}
+			if !reflect.DeepEqual(fake.calls, tt.wantCalls) {
+				t.Errorf("calls = %v, want %v", fake.calls, tt.wantCalls)
+			}
+			if fake.lists != tt.wantLists {
+				t.Errorf("list requests = %d, want %d", fake.lists, tt.wantLists)
+			}
+			if len(plan.Entries) != len(tt.planned) {
+				t.Fatalf("model entries = %d, want %d", len(plan.Entries), len(tt.planned))
+			}
+			for i, e := range plan.Entries {
+				want := tt.planned[i]
+				if e.Address != want.Address || e.AccessType != want.AccessType {
+					t.Errorf("model entry %d = %s/%s, want %s/%s", i, e.Address, e.AccessType, want.Address, want.AccessType)
+				}
+			}
+		})
+	}
+}
+
+func TestSiteIPAccessListDeleteSkipsMissing(t *testing.T) {
+	fake := &fakeIPAccessList{entries: ipAccessEntries("10.0.0.1=Allow", "10.0.0.5=Deny!")}
+	mux := http.NewServeMux()
+	fake.register(mux)
+	c := newTestClient(t, mux)
+
+	current, err := c.ListSiteIPAccess(context.Background(), "site-1")
+	if err != nil {
+		t.Fatalf("ListSiteIPAccess() error: %v", err)
+	}
+	state := ipAccessEntryModels("10.0.0.1=Allow", "10.0.0.2=Deny")
+	for _, address := range existingIPAccessAddresses(current, state) {
+		if err := c.DeleteSiteIPAccess(context.Background(), "site-1", address); err != nil {
+			t.Fatalf("DeleteSiteIPAccess(%s) error: %v", address, err)
+		}
+	}
+
+	if want := []string{"delete 10.0.0.1"}; !reflect.DeepEqual(fake.calls, want) {
+		t.Errorf("calls = %v, want %v", fake.calls, want)
+	}
+	if len(fake.entries) != 1 || !fake.entries[0].Attributes.IsAutoBan {
+		t.Errorf("remaining entries = %v, want only the auto-ban", fake.entries)
+	}
+}
@@ -0,0 +1,229 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &siteIPAccessRuleResource{}
+var _ resource.ResourceWithConfigure = &siteIPAccessRuleResource{}
+var _ resource.ResourceWithImportState = &siteIPAccessRuleResource{}
+
+func NewSiteIPAccessRuleResource() resource.Resource {
+	return &siteIPAccessRuleResource{}
+}
+
+type siteIPAccessRuleResource struct {
+	client *client.Client
+}
+
+type siteIPAccessRuleResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	SiteID         types.String `tfsdk:"site_id"`
+	Address        types.String `tfsdk:"address"`
+	AccessType     types.String `tfsdk:"access_type"`
+	Reason         types.String `tfsdk:"reason"`
+	SequenceNumber types.Int64  `tfsdk:"sequence_number"`
+	Date           types.String `tfsdk:"date"`
+}
+
+func (r *siteIPAccessRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_site_ip_access_rule"
+}
+
+func (r *siteIPAccessRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a single entry of a Globalscape EFT site IP access list. Do not combine with `globalscapeeft_site_ip_access_list` on the same site.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier in the form `<site_id>/<address>`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site_id": schema.StringAttribute{
+				MarkdownDescription: "Site identifier the entry belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"address": schema.StringAttribute{
+				MarkdownDescription: "IP address or wildcard mask the entry applies to, e.g. `192.168.100.*`. Changing it forces a new resource.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"access_type": schema.StringAttribute{
+				MarkdownDescription: "Either `Allow` or `Deny`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.IPAccessAllow, client.IPAccessDeny),
+				},
+			},
+			"reason": schema.StringAttribute{
+				MarkdownDescription: "Free-form note shown in the EFT administrator.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"sequence_number": optionalComputedInt64("Position of the entry in the list. EFT appends the entry when unset.", int64validator.AtLeast(1)),
+			"date": schema.StringAttribute{
+				MarkdownDescription: "Date the entry was last changed, as reported by EFT.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *siteIPAccessRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if c, ok := req.ProviderData.(*client.Client); ok {
+		r.client = c
+	}
+}
+
+func (r *siteIPAccessRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var plan siteIPAccessRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entry, err := r.client.CreateSiteIPAccess(ctx, plan.SiteID.ValueString(), plan.Address.ValueString(), plan.toAPIModel())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create IP access rule", err.Error())
+		return
+	}
+
+	plan.fromAPI(entry)
+	plan.ID = types.StringValue(fmt.Sprintf("%s/%s", plan.SiteID.ValueString(), plan.Address.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read looks the entry up in the full list so that an entry removed outside
+// Terraform is dropped from state instead of failing the refresh.
+func (r *siteIPAccessRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var state siteIPAccessRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entries, err := r.client.ListSiteIPAccess(ctx, state.SiteID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read IP access list", err.Error())
+		return
+	}
+
+	for i := range entries {
+		if entries[i].ID == state.Address.ValueString() {
+			state.fromAPI(&entries[i])
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *siteIPAccessRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var plan siteIPAccessRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entry, err := r.client.UpdateSiteIPAccess(ctx, plan.SiteID.ValueString(), plan.Address.ValueString(), plan.toAPIModel())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update IP access rule", err.Error())
+		return
+	}
+
+	plan.fromAPI(entry)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *siteIPAccessRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var state siteIPAccessRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteSiteIPAccess(ctx, state.SiteID.ValueString(), state.Address.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Failed to delete IP access rule", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *siteIPAccessRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	siteID, address, ok := strings.Cut(req.ID, "/")
+	if !ok || siteID == "" || address == "" {
+		resp.Diagnostics.AddError("Invalid import identifier", "Expected identifier in the form <site_id>/<address>")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site_id"), siteID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("address"), address)...)
+}
+
+func (m *siteIPAccessRuleResourceModel) toAPIModel() client.IPAccessUpdate {
+	return client.IPAccessUpdate{
+		AccessType:     m.AccessType.ValueString(),
+		Reason:         stringValueOrEmpty(m.Reason),
+		SequenceNumber: int64ValueOrZero(m.SequenceNumber),
+	}
+}
+
+func (m *siteIPAccessRuleResourceModel) fromAPI(entry *client.IPAccessEntry) {
+	m.Address = types.StringValue(entry.ID)
+	m.AccessType = types.StringValue(entry.Attributes.AccessType)
+	m.Reason = types.StringValue(entry.Attributes.Reason)
+	m.SequenceNumber = types.Int64Value(entry.Attributes.SequenceNumber)
+	m.Date = types.StringValue(entry.Attributes.Date)
+}
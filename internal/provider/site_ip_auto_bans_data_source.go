@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &siteIPAutoBansDataSource{}
+
+func NewSiteIPAutoBansDataSource() datasource.DataSource {
+	return &siteIPAutoBansDataSource{}
+}
+
+type siteIPAutoBansDataSource struct {
+	client *client.Client
+}
+
+type siteIPAutoBansDataSourceModel struct {
+	SiteID types.String         `tfsdk:"site_id"`
+	Bans   []siteIPAutoBanModel `tfsdk:"bans"`
+}
+
+type siteIPAutoBanModel struct {
+	ID   types.String `tfsdk:"id"`
+	Date types.String `tfsdk:"date"`
+}
+
+func (d *siteIPAutoBansDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_site_ip_auto_bans"
+}
+
+func (d *siteIPAutoBansDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the IP addresses a Globalscape EFT site banned automatically after flood or invalid-login detection.",
+		Attributes: map[string]schema.Attribute{
+			"site_id": schema.StringAttribute{
+				MarkdownDescription: "Site identifier.",
+				Required:            true,
+			},
+			"bans": schema.ListNestedAttribute{
+				MarkdownDescription: "Active automatic bans.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Ban identifier, which is the banned IP address. Pass it to the `globalscapeeft_site_ip_unban` action.",
+							Computed:            true,
+						},
+						"date": schema.StringAttribute{
+							MarkdownDescription: "Date of the ban taken from the site IP access list. Null when EFT does not report one or the access list cannot be read.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *siteIPAutoBansDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if c, ok := req.ProviderData.(*client.Client); ok {
+		d.client = c
+	}
+}
+
+func (d *siteIPAutoBansDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var data siteIPAutoBansDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	siteID := data.SiteID.ValueString()
+	bans, err := d.client.ListSiteIPAutoBans(ctx, siteID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to list auto-banned addresses", err.Error())
+		return
+	}
+
+	dates, diags := lookupAutoBanDates(ctx, d.client, siteID)
+	resp.Diagnostics.Append(diags...)
+
+	data.Bans = []siteIPAutoBanModel{}
+	for _, b := range bans {
+		date := types.StringNull()
+		if v := dates[b.ID]; v != "" {
+			date = types.StringValue(v)
+		}
+		data.Bans = append(data.Bans, siteIPAutoBanModel{
+			ID:   types.StringValue(b.ID),
+			Date: date,
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// lookupAutoBanDates returns the ban date of every auto-banned address. The
+// auto-ban list only carries IDs, so the dates come from the site IP access
+// list. They are informational: a failure only produces a warning and a nil
+// map, which leaves every date null.
+func lookupAutoBanDates(ctx context.Context, c *client.Client, siteID string) (map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	entries, err := c.ListSiteIPAccess(ctx, siteID)
+	if err != nil {
+		diags.AddWarning("Unable to read IP access list", "Ban dates are left empty. "+err.Error())
+		return nil, diags
+	}
+
+	dates := make(map[string]string, len(entries))
+	for _, e := range entries {
+		if e.Attributes.IsAutoBan {
+			dates[e.ID] = e.Attributes.Date
+		}
+	}
+	return dates, diags
+}
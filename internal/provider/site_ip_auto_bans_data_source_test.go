@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestLookupAutoBanDates(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		body        string
+		wantWarning bool
+		want        map[string]string
+	}{
+		{
+			name:   "dates of auto-bans only",
+			status: http.StatusOK,
+			body: `{"data":[
+				{"id":"10.0.0.1","attributes":{"accessType":"Deny","isAutoBan":true,"date":"2025-01-02T03:04:05"}},
+				{"id":"10.0.0.2","attributes":{"accessType":"Deny","date":"2025-01-01T00:00:00"}}
+			]}`,
+			want: map[string]string{"10.0.0.1": "2025-01-02T03:04:05"},
+		},
+		{
+			name:        "access list fails",
+			status:      http.StatusInternalServerError,
+			body:        `{"message":"boom"}`,
+			wantWarning: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /admin/v2/sites/site-1/ipAccessList", func(w http.ResponseWriter, _ *http.Request) {
+				writeTestJSON(w, tt.status, tt.body)
+			})
+			c := newTestClient(t, mux)
+
+			dates, diags := lookupAutoBanDates(context.Background(), c, "site-1")
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if got := diags.WarningsCount() > 0; got != tt.wantWarning {
+				t.Fatalf("warning = %t, want %t", got, tt.wantWarning)
+			}
+			if tt.wantWarning {
+				if dates != nil {
+					t.Fatalf("expected nil dates on failure, got %v", dates)
+				}
+				return
+			}
+			if !reflect.DeepEqual(dates, tt.want) {
+				t.Errorf("dates = %v, want %v", dates, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ action.Action = &siteIPUnbanAction{}
+var _ action.ActionWithConfigure = &siteIPUnbanAction{}
+
+func NewSiteIPUnbanAction() action.Action {
+	return &siteIPUnbanAction{}
+}
+
+type siteIPUnbanAction struct {
+	client *client.Client
+}
+
+type siteIPUnbanActionModel struct {
+	SiteID types.String `tfsdk:"site_id"`
+	BanID  types.String `tfsdk:"ban_id"`
+}
+
+func (a *siteIPUnbanAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_site_ip_unban"
+}
+
+func (a *siteIPUnbanAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lifts an automatic IP ban on a Globalscape EFT site.",
+		Attributes: map[string]schema.Attribute{
+			"site_id": schema.StringAttribute{
+				MarkdownDescription: "Site identifier.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"ban_id": schema.StringAttribute{
+				MarkdownDescription: "Ban identifier as returned by the `globalscapeeft_site_ip_auto_bans` data source, i.e. the banned IP address.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (a *siteIPUnbanAction) Configure(_ context.Context, req action.ConfigureRequest, _ *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if c, ok := req.ProviderData.(*client.Client); ok {
+		a.client = c
+	}
+}
+
+func (a *siteIPUnbanAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	if a.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var config siteIPUnbanActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := a.client.DeleteSiteIPAutoBan(ctx, config.SiteID.ValueString(), config.BanID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Failed to lift IP ban", err.Error())
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("lifted auto-ban on %s", config.BanID.ValueString())})
+}
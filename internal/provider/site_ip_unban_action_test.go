@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func invokeSiteIPUnban(t *testing.T, mux *http.ServeMux, siteID, banID string) (*action.InvokeResponse, []string) {
+	t.Helper()
+	ctx := context.Background()
+
+	a := &siteIPUnbanAction{client: newTestClient(t, mux)}
+	var schemaResp action.SchemaResponse
+	a.Schema(ctx, action.SchemaRequest{}, &schemaResp)
+
+	config := tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"site_id": tftypes.NewValue(tftypes.String, siteID),
+			"ban_id":  tftypes.NewValue(tftypes.String, banID),
+		}),
+	}
+
+	var progress []string
+	resp := &action.InvokeResponse{
+		SendProgress: func(event action.InvokeProgressEvent) { progress = append(progress, event.Message) },
+	}
+	a.Invoke(ctx, action.InvokeRequest{Config: config}, resp)
+	return resp, progress
+}
+
+func TestSiteIPUnbanActionInvoke(t *testing.T) {
+	deleted := false
+	mux := http.NewServeMux()
+	mux.HandleFunc("DELETE /admin/v2/sites/site-1/ipAutoBanList/203.0.113.7", func(w http.ResponseWriter, _ *http.Request) {
+		deleted = true
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	resp, progress := invokeSiteIPUnban(t, mux, "site-1", "203.0.113.7")
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Invoke() diagnostics: %v", resp.Diagnostics)
+	}
+	if !deleted {
+		t.Fatal("ban was not deleted")
+	}
+	if len(progress) != 1 || !strings.Contains(progress[0], "203.0.113.7") {
+		t.Errorf("progress = %v, want one message naming the ban", progress)
+	}
+}
+
+func TestSiteIPUnbanActionInvokeAPIError(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("DELETE /admin/v2/sites/site-1/ipAutoBanList/203.0.113.7", func(w http.ResponseWriter, _ *http.Request) {
+		writeTestJSON(w, http.StatusNotFound, `{"errors":[{"detail":"ban not found"}]}`)
+	})
+
+	resp, progress := invokeSiteIPUnban(t, mux, "site-1", "203.0.113.7")
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Invoke() succeeded, want error")
+	}
+	errs := resp.Diagnostics.Errors()
+	if got := errs[0].Summary(); got != "Failed to lift IP ban" {
+		t.Errorf("summary = %q, want %q", got, "Failed to lift IP ban")
+	}
+	if got := errs[0].Detail(); !strings.Contains(got, "ban not found") {
+		t.Errorf("detail = %q, want the API error", got)
+	}
+	if len(progress) != 0 {
+		t.Errorf("progress = %v, want none after a failure", progress)
+	}
+}
@@ -0,0 +1,361 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var _ resource.Resource = &siteNetworkSecurityResource{}
+var _ resource.ResourceWithConfigure = &siteNetworkSecurityResource{}
+var _ resource.ResourceWithImportState = &siteNetworkSecurityResource{}
+
+func NewSiteNetworkSecurityResource() resource.Resource {
+	return &siteNetworkSecurityResource{}
+}
+
+type siteNetworkSecurityResource struct {
+	client *client.Client
+}
+
+type siteNetworkSecurityResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	SiteID           types.String `tfsdk:"site_id"`
+	ConnectionLimits types.Object `tfsdk:"connection_limits"`
+	AutoBan          types.Object `tfsdk:"auto_ban"`
+}
+
+type siteConnectionLimitsModel struct {
+	MaxTransferSpeedKbps           types.Int64 `tfsdk:"max_transfer_speed_kbps"`
+	MaxConcurrentSocketConnections types.Int64 `tfsdk:"max_concurrent_socket_connections"`
+	MaxConcurrentLogins            types.Int64 `tfsdk:"max_concurrent_logins"`
+	MaxConnectionsPerUser          types.Int64 `tfsdk:"max_connections_per_user"`
+	MaxConnectionsPerIP            types.Int64 `tfsdk:"max_connections_per_ip"`
+}
+
+type siteAutoBanModel struct {
+	Enabled                       types.Bool   `tfsdk:"enabled"`
+	BanIfExcessiveInvalidCommands types.Bool   `tfsdk:"ban_if_excessive_invalid_commands"`
+	InvalidCommandLimit           types.Int64  `tfsdk:"invalid_command_limit"`
+	SensitivityLevel              types.String `tfsdk:"sensitivity_level"`
+	BanPermanently                types.Bool   `tfsdk:"ban_permanently"`
+}
+
+var siteConnectionLimitsAttrTypes = map[string]attr.Type{
+	"max_transfer_speed_kbps":           types.Int64Type,
+	"max_concurrent_socket_connections": types.Int64Type,
+	"max_concurrent_logins":             types.Int64Type,
+	"max_connections_per_user":          types.Int64Type,
+	"max_connections_per_ip":            types.Int64Type,
+}
+
+var siteAutoBanAttrTypes = map[string]attr.Type{
+	"enabled":                           types.BoolType,
+	"ban_if_excessive_invalid_commands": types.BoolType,
+	"invalid_command_limit":             types.Int64Type,
+	"sensitivity_level":                 types.StringType,
+	"ban_permanently":                   types.BoolType,
+}
+
+func (r *siteNetworkSecurityResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_site_network_security"
+}
+
+func (r *siteNetworkSecurityResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the connection limits and denial-of-service (auto-ban) settings of a Globalscape EFT site. Every section is read back into state; omitted sections are reported but not changed. Destroying this resource will only remove it from Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Site identifier.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site_id": schema.StringAttribute{
+				MarkdownDescription: "Site identifier whose network and security settings are managed.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"connection_limits": schema.SingleNestedAttribute{
+				MarkdownDescription: "Connection limits of the site. Each limit is disabled when set to `0`. Read back from EFT even when omitted.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"max_transfer_speed_kbps":           optionalComputedInt64("Maximum transfer speed per connection, in KB/s.", int64validator.AtLeast(0)),
+					"max_concurrent_socket_connections": optionalComputedInt64("Maximum number of concurrent socket connections.", int64validator.AtLeast(0)),
+					"max_concurrent_logins":             optionalComputedInt64("Maximum number of concurrent logins.", int64validator.AtLeast(0)),
+					"max_connections_per_user":          optionalComputedInt64("Maximum number of connections per user.", int64validator.AtLeast(0)),
+					"max_connections_per_ip":            optionalComputedInt64("Maximum number of connections from the same IP address.", int64validator.AtLeast(0)),
+				},
+			},
+			"auto_ban": schema.SingleNestedAttribute{
+				MarkdownDescription: "Denial-of-service protection that automatically bans offending IP addresses. Read back from EFT even when omitted.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"enabled":                           optionalComputedBool("Whether flood/DoS auto-ban is enabled."),
+					"ban_if_excessive_invalid_commands": optionalComputedBool("Ban clients that send too many invalid commands."),
+					"invalid_command_limit":             optionalComputedInt64("Number of invalid commands that triggers a ban.", int64validator.AtLeast(1)),
+					"sensitivity_level": schema.StringAttribute{
+						MarkdownDescription: "Flood detection sensitivity, e.g. `Low`, `Medium` or `High`.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"ban_permanently": optionalComputedBool("Ban offending IP addresses permanently instead of temporarily."),
+				},
+			},
+		},
+	}
+}
+
+func (r *siteNetworkSecurityResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if c, ok := req.ProviderData.(*client.Client); ok {
+		r.client = c
+	}
+}
+
+func (r *siteNetworkSecurityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var plan siteNetworkSecurityResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.SiteID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *siteNetworkSecurityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var state siteNetworkSecurityResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.SiteID.IsNull() {
+		state.SiteID = state.ID
+	}
+
+	settings, err := r.client.GetSiteNetworkSecurity(ctx, state.SiteID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read site network and security settings", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(state.fromAPI(settings)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *siteNetworkSecurityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var plan siteNetworkSecurityResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *siteNetworkSecurityResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Network and security settings are part of the site and cannot be deleted via the API.
+	// Removing the resource from Terraform state only; the site keeps its configuration.
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *siteNetworkSecurityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site_id"), req.ID)...)
+}
+
+// apply merges the plan over the current settings before patching, because
+// EFT expects each section to be sent as a whole.
+func (r *siteNetworkSecurityResource) apply(ctx context.Context, plan *siteNetworkSecurityResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	siteID := plan.SiteID.ValueString()
+
+	current, err := r.client.GetSiteNetworkSecurity(ctx, siteID)
+	if err != nil {
+		diags.AddError("Failed to read site network and security settings", err.Error())
+		return diags
+	}
+
+	limits, autoBan, d := plan.toAPIModel(ctx, current)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	settings, err := r.client.UpdateSiteNetworkSecurity(ctx, siteID, limits, autoBan)
+	if err != nil {
+		diags.AddError("Failed to update site network and security settings", err.Error())
+		return diags
+	}
+
+	diags.Append(plan.fromAPI(settings)...)
+	return diags
+}
+
+// toAPIModel only returns the sections that are known in the plan. A section
+// omitted on create is unknown and left untouched; on update it carries the
+// refreshed state, so sending it back changes nothing.
+func (m *siteNetworkSecurityResourceModel) toAPIModel(ctx context.Context, current *client.SiteNetworkSecurity) (*client.ConnectionLimits, *client.AutoBanUpdate, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var limits *client.ConnectionLimits
+	if !m.ConnectionLimits.IsNull() && !m.ConnectionLimits.IsUnknown() {
+		var cl siteConnectionLimitsModel
+		diags.Append(m.ConnectionLimits.As(ctx, &cl, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, nil, diags
+		}
+		l := current.ConnectionLimits
+		connectionLimitToAPI(cl.MaxTransferSpeedKbps, &l.MaxTransferSpeed, &l.MaxTransferSpeedValue)
+		connectionLimitToAPI(cl.MaxConcurrentSocketConnections, &l.MaxConcurrentSocketConnections, &l.MaxConcurrentSocketConnectionsValue)
+		connectionLimitToAPI(cl.MaxConcurrentLogins, &l.MaxConcurrentLogins, &l.MaxConcurrentLoginsValue)
+		connectionLimitToAPI(cl.MaxConnectionsPerUser, &l.MaxConnectionsPerUser, &l.MaxConnectionsPerUserValue)
+		connectionLimitToAPI(cl.MaxConnectionsPerIP, &l.MaxConnectionFromSameIP, &l.MaxConnectionFromSameIPValue)
+		limits = &l
+	}
+
+	var autoBan *client.AutoBanUpdate
+	if !m.AutoBan.IsNull() && !m.AutoBan.IsUnknown() {
+		var ab siteAutoBanModel
+		diags.Append(m.AutoBan.As(ctx, &ab, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, nil, diags
+		}
+		cur := current.AutoBan
+		u := client.AutoBanUpdate{
+			Enabled: cur.Enabled,
+			Value: client.AutoBanUpdateValue{
+				BanIfExcessiveInvalidCmdsReceived: cur.Value.BanIfExcessiveInvalidCmdsReceived,
+				BanIPsPermanently:                 cur.Value.BanIPsPermanently,
+				NumberOfInvalidCmds:               cur.Value.NumberOfInvalidCmds,
+				SensitivityLevel:                  cur.Value.SensitivityLevel,
+			},
+		}
+		if v := boolPointer(ab.Enabled); v != nil {
+			u.Enabled = *v
+		}
+		if v := boolPointer(ab.BanIfExcessiveInvalidCommands); v != nil {
+			u.Value.BanIfExcessiveInvalidCmdsReceived = *v
+		}
+		if v := boolPointer(ab.BanPermanently); v != nil {
+			u.Value.BanIPsPermanently = *v
+		}
+		if v := int64Pointer(ab.InvalidCommandLimit); v != nil {
+			u.Value.NumberOfInvalidCmds = *v
+		}
+		if v := stringValueOrEmpty(ab.SensitivityLevel); v != "" {
+			u.Value.SensitivityLevel = v
+		}
+		autoBan = &u
+	}
+
+	return limits, autoBan, diags
+}
+
+// fromAPI refreshes every section so that changes made outside Terraform,
+// including in sections that are not configured, show up in state.
+func (m *siteNetworkSecurityResourceModel) fromAPI(settings *client.SiteNetworkSecurity) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	l := settings.ConnectionLimits
+	limits, d := types.ObjectValue(siteConnectionLimitsAttrTypes, map[string]attr.Value{
+		"max_transfer_speed_kbps":           connectionLimitFromAPI(l.MaxTransferSpeed, l.MaxTransferSpeedValue),
+		"max_concurrent_socket_connections": connectionLimitFromAPI(l.MaxConcurrentSocketConnections, l.MaxConcurrentSocketConnectionsValue),
+		"max_concurrent_logins":             connectionLimitFromAPI(l.MaxConcurrentLogins, l.MaxConcurrentLoginsValue),
+		"max_connections_per_user":          connectionLimitFromAPI(l.MaxConnectionsPerUser, l.MaxConnectionsPerUserValue),
+		"max_connections_per_ip":            connectionLimitFromAPI(l.MaxConnectionFromSameIP, l.MaxConnectionFromSameIPValue),
+	})
+	diags.Append(d...)
+
+	ab := settings.AutoBan
+	autoBan, d := types.ObjectValue(siteAutoBanAttrTypes, map[string]attr.Value{
+		"enabled":                           types.BoolValue(ab.Enabled),
+		"ban_if_excessive_invalid_commands": types.BoolValue(ab.Value.BanIfExcessiveInvalidCmdsReceived),
+		"invalid_command_limit":             types.Int64Value(ab.Value.NumberOfInvalidCmds),
+		"sensitivity_level":                 types.StringValue(ab.Value.SensitivityLevel),
+		"ban_permanently":                   types.BoolValue(ab.Value.BanIPsPermanently),
+	})
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return diags
+	}
+	m.ConnectionLimits = limits
+	m.AutoBan = autoBan
+	return diags
+}
+
+func connectionLimitToAPI(v types.Int64, enabled *bool, value *int64) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+	*enabled = v.ValueInt64() > 0
+	if *enabled {
+		*value = v.ValueInt64()
+	}
+}
+
+func connectionLimitFromAPI(enabled bool, value int64) types.Int64 {
+	if !enabled {
+		return types.Int64Value(0)
+	}
+	return types.Int64Value(value)
+}
@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testSiteNetworkSecurity() *client.SiteNetworkSecurity {
+	return &client.SiteNetworkSecurity{
+		ConnectionLimits: client.ConnectionLimits{
+			MaxConcurrentLogins:      true,
+			MaxConcurrentLoginsValue: 200,
+			MaxConnectionFromSameIP:  false,
+		},
+		AutoBan: client.AutoBan{
+			Enabled: true,
+			Value:   client.AutoBanValue{NumberOfInvalidCmds: 5, SensitivityLevel: "Medium"},
+		},
+	}
+}
+
+func TestSiteNetworkSecurityFromAPI(t *testing.T) {
+	// An imported resource has no sections yet; every section must be filled.
+	m := siteNetworkSecurityResourceModel{
+		ConnectionLimits: types.ObjectNull(siteConnectionLimitsAttrTypes),
+		AutoBan:          types.ObjectNull(siteAutoBanAttrTypes),
+	}
+	if diags := m.fromAPI(testSiteNetworkSecurity()); diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+
+	limits := m.ConnectionLimits.Attributes()
+	if got := limits["max_concurrent_logins"].(types.Int64).ValueInt64(); got != 200 {
+		t.Fatalf("max_concurrent_logins = %d, want 200", got)
+	}
+	if got := limits["max_connections_per_ip"].(types.Int64).ValueInt64(); got != 0 {
+		t.Fatalf("disabled limit = %d, want 0", got)
+	}
+
+	autoBan := m.AutoBan.Attributes()
+	if !autoBan["enabled"].(types.Bool).ValueBool() || autoBan["sensitivity_level"].(types.String).ValueString() != "Medium" {
+		t.Fatalf("unexpected auto_ban %v", autoBan)
+	}
+}
+
+func TestSiteNetworkSecurityToAPIModel(t *testing.T) {
+	ctx := context.Background()
+
+	limits := types.ObjectValueMust(siteConnectionLimitsAttrTypes, map[string]attr.Value{
+		"max_transfer_speed_kbps":           types.Int64Unknown(),
+		"max_concurrent_socket_connections": types.Int64Unknown(),
+		"max_concurrent_logins":             types.Int64Value(0),
+		"max_connections_per_user":          types.Int64Unknown(),
+		"max_connections_per_ip":            types.Int64Value(10),
+	})
+
+	tests := []struct {
+		name        string
+		model       siteNetworkSecurityResourceModel
+		wantLimits  bool
+		wantAutoBan bool
+	}{
+		{
+			name: "omitted sections on create are not sent",
+			model: siteNetworkSecurityResourceModel{
+				ConnectionLimits: types.ObjectUnknown(siteConnectionLimitsAttrTypes),
+				AutoBan:          types.ObjectUnknown(siteAutoBanAttrTypes),
+			},
+		},
+		{
+			name: "configured section merged over current",
+			model: siteNetworkSecurityResourceModel{
+				ConnectionLimits: limits,
+				AutoBan:          types.ObjectUnknown(siteAutoBanAttrTypes),
+			},
+			wantLimits: true,
+		},
+		{
+			name: "partial auto_ban keeps current values",
+			model: siteNetworkSecurityResourceModel{
+				ConnectionLimits: types.ObjectUnknown(siteConnectionLimitsAttrTypes),
+				AutoBan: types.ObjectValueMust(siteAutoBanAttrTypes, map[string]attr.Value{
+					"enabled":                           types.BoolUnknown(),
+					"ban_if_excessive_invalid_commands": types.BoolValue(true),
+					"invalid_command_limit":             types.Int64Value(3),
+					"sensitivity_level":                 types.StringUnknown(),
+					"ban_permanently":                   types.BoolUnknown(),
+				}),
+			},
+			wantAutoBan: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotLimits, gotAutoBan, diags := tt.model.toAPIModel(ctx, testSiteNetworkSecurity())
+			if diags.HasError() {
+				t.Fatalf("unexpected errors: %v", diags)
+			}
+			if (gotLimits != nil) != tt.wantLimits || (gotAutoBan != nil) != tt.wantAutoBan {
+				t.Fatalf("got limits %v and auto_ban %v", gotLimits, gotAutoBan)
+			}
+			if gotAutoBan != nil {
+				v := gotAutoBan.Value
+				if !gotAutoBan.Enabled || v.SensitivityLevel != "Medium" || v.NumberOfInvalidCmds != 3 || !v.BanIfExcessiveInvalidCmdsReceived {
+					t.Fatalf("auto_ban not merged over current settings: %+v", *gotAutoBan)
+				}
+			}
+			if gotLimits == nil {
+				return
+			}
+			if gotLimits.MaxConcurrentLogins {
+				t.Fatal("a limit of 0 must disable max_concurrent_logins")
+			}
+			if !gotLimits.MaxConnectionFromSameIP || gotLimits.MaxConnectionFromSameIPValue != 10 {
+				t.Fatalf("max_connections_per_ip not applied: %+v", gotLimits)
+			}
+		})
+	}
+}
@@ -0,0 +1,204 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &sitePrivacyPolicyResource{}
+var _ resource.ResourceWithConfigure = &sitePrivacyPolicyResource{}
+var _ resource.ResourceWithImportState = &sitePrivacyPolicyResource{}
+
+func NewSitePrivacyPolicyResource() resource.Resource {
+	return &sitePrivacyPolicyResource{}
+}
+
+type sitePrivacyPolicyResource struct {
+	client *client.Client
+}
+
+type sitePrivacyPolicyResourceModel struct {
+	ID                      types.String `tfsdk:"id"`
+	SiteID                  types.String `tfsdk:"site_id"`
+	Type                    types.String `tfsdk:"type"`
+	PreventSftpsUntilAgreed types.Bool   `tfsdk:"prevent_sftps_until_agreed"`
+	ImpliedForNonSubjects   types.Bool   `tfsdk:"implied_for_non_subjects"`
+	AgreementPath           types.String `tfsdk:"agreement_path"`
+	AgreementLabel          types.String `tfsdk:"agreement_label"`
+	EffectiveDate           types.String `tfsdk:"effective_date"`
+}
+
+func (r *sitePrivacyPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_site_privacy_policy"
+}
+
+func (r *sitePrivacyPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the privacy policy that users of a Globalscape EFT site must consent to. Only the configured attributes are sent to the API. Destroying this resource will only remove it from Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Site identifier.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site_id": schema.StringAttribute{
+				MarkdownDescription: "Site identifier whose privacy policy is managed.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type":                       optionalComputedString("How consent is collected, e.g. `Mandatory` or `Disabled`.", stringvalidator.LengthAtLeast(1)),
+			"prevent_sftps_until_agreed": optionalComputedBool("Refuse SFTP and FTPS transfers until the user has consented."),
+			"implied_for_non_subjects":   optionalComputedBool("Treat consent as given for users who are not EU data subjects."),
+			"agreement_path":             optionalComputedString("Path, on the EFT server, of the privacy policy document shown to users."),
+			"agreement_label":            optionalComputedString("Label of the consent checkbox."),
+			"effective_date": schema.StringAttribute{
+				MarkdownDescription: "Date from which the current policy applies. EFT sets it when the policy changes.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *sitePrivacyPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if c, ok := req.ProviderData.(*client.Client); ok {
+		r.client = c
+	}
+}
+
+func (r *sitePrivacyPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var plan sitePrivacyPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := r.client.UpdateSitePrivacyPolicy(ctx, plan.SiteID.ValueString(), plan.toAPIModel())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update site privacy policy", err.Error())
+		return
+	}
+
+	newState := fromSitePrivacyPolicy(policy)
+	newState.ID = plan.SiteID
+	newState.SiteID = plan.SiteID
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *sitePrivacyPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var state sitePrivacyPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	siteID := state.SiteID
+	if siteID.IsNull() {
+		siteID = state.ID
+	}
+
+	policy, err := r.client.GetSitePrivacyPolicy(ctx, siteID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read site privacy policy", err.Error())
+		return
+	}
+
+	newState := fromSitePrivacyPolicy(policy)
+	newState.ID = siteID
+	newState.SiteID = siteID
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *sitePrivacyPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var plan sitePrivacyPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := r.client.UpdateSitePrivacyPolicy(ctx, plan.SiteID.ValueString(), plan.toAPIModel())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update site privacy policy", err.Error())
+		return
+	}
+
+	newState := fromSitePrivacyPolicy(policy)
+	newState.ID = plan.ID
+	newState.SiteID = plan.SiteID
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *sitePrivacyPolicyResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The privacy policy is part of the site and cannot be deleted via the API.
+	// Removing the resource from Terraform state only; the site keeps its configuration.
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *sitePrivacyPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site_id"), req.ID)...)
+}
+
+func (m *sitePrivacyPolicyResourceModel) toAPIModel() client.SiteAgreement {
+	policy := client.SiteAgreement{
+		Type:           stringPointer(m.Type),
+		AgreementPath:  stringPointer(m.AgreementPath),
+		AgreementLabel: stringPointer(m.AgreementLabel),
+	}
+
+	values := client.AgreementValues{
+		PreventSftpsUntilAgreed: boolPointer(m.PreventSftpsUntilAgreed),
+		ImpliedForNonSubjects:   boolPointer(m.ImpliedForNonSubjects),
+	}
+	if values.PreventSftpsUntilAgreed != nil || values.ImpliedForNonSubjects != nil {
+		policy.Values = &values
+	}
+
+	return policy
+}
+
+func fromSitePrivacyPolicy(policy *client.SiteAgreement) *sitePrivacyPolicyResourceModel {
+	values := policy.Values
+	if values == nil {
+		values = &client.AgreementValues{}
+	}
+
+	return &sitePrivacyPolicyResourceModel{
+		Type:                    stringFromPointer(policy.Type),
+		PreventSftpsUntilAgreed: boolFromPointer(values.PreventSftpsUntilAgreed),
+		ImpliedForNonSubjects:   boolFromPointer(values.ImpliedForNonSubjects),
+		AgreementPath:           stringFromPointer(policy.AgreementPath),
+		AgreementLabel:          stringFromPointer(policy.AgreementLabel),
+		EffectiveDate:           stringFromPointer(policy.EffectiveDate),
+	}
+}
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSitePrivacyPolicyToAPIModel(t *testing.T) {
+	tests := []struct {
+		name  string
+		model sitePrivacyPolicyResourceModel
+		want  string
+	}{
+		{
+			name: "values omitted when unknown",
+			model: sitePrivacyPolicyResourceModel{
+				Type:                    types.StringValue("Mandatory"),
+				PreventSftpsUntilAgreed: types.BoolUnknown(),
+				ImpliedForNonSubjects:   types.BoolNull(),
+				AgreementPath:           types.StringValue("/Privacy.html"),
+				AgreementLabel:          types.StringUnknown(),
+			},
+			want: `{"type":"Mandatory","agreementPath":"/Privacy.html"}`,
+		},
+		{
+			name: "values nested",
+			model: sitePrivacyPolicyResourceModel{
+				Type:                    types.StringUnknown(),
+				PreventSftpsUntilAgreed: types.BoolValue(true),
+				ImpliedForNonSubjects:   types.BoolValue(false),
+				AgreementPath:           types.StringNull(),
+				AgreementLabel:          types.StringNull(),
+			},
+			want: `{"values":{"preventSftpsUntilAgreed":true,"impliedForNonSubjects":false}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, _ := json.Marshal(tt.model.toAPIModel())
+			if string(body) != tt.want {
+				t.Errorf("toAPIModel() =\n%s\nwant\n%s", body, tt.want)
+			}
+		})
+	}
+}
+
+func TestFromSitePrivacyPolicy(t *testing.T) {
+	var policy client.SiteAgreement
+	body := `{"type":"Mandatory","values":{"impliedForNonSubjects":true},"agreementPath":"/Privacy.html","effectiveDate":"2025-05-01"}`
+	if err := json.Unmarshal([]byte(body), &policy); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	m := fromSitePrivacyPolicy(&policy)
+	if m.Type.ValueString() != "Mandatory" || m.AgreementPath.ValueString() != "/Privacy.html" || m.EffectiveDate.ValueString() != "2025-05-01" {
+		t.Errorf("agreement not read back: %+v", m)
+	}
+	if !m.ImpliedForNonSubjects.ValueBool() || !m.PreventSftpsUntilAgreed.IsNull() {
+		t.Errorf("values = %s/%s", m.ImpliedForNonSubjects, m.PreventSftpsUntilAgreed)
+	}
+
+	empty := fromSitePrivacyPolicy(&client.SiteAgreement{})
+	if !empty.ImpliedForNonSubjects.IsNull() || !empty.Type.IsNull() {
+		t.Errorf("missing values not null: %+v", empty)
+	}
+}
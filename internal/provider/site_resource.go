@@ -0,0 +1,313 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &siteResource{}
+var _ resource.ResourceWithConfigure = &siteResource{}
+var _ resource.ResourceWithImportState = &siteResource{}
+var _ resource.ResourceWithModifyPlan = &siteResource{}
+
+func NewSiteResource() resource.Resource {
+	return &siteResource{}
+}
+
+type siteResource struct {
+	client *client.Client
+}
+
+type siteResourceModel struct {
+	ID                        types.String   `tfsdk:"id"`
+	Name                      types.String   `tfsdk:"name"`
+	RootFolder                types.String   `tfsdk:"root_folder"`
+	PCIComplianceEnabled      types.Bool     `tfsdk:"pci_compliance_enabled"`
+	CreateUnixStyleSubFolders types.Bool     `tfsdk:"create_unix_style_subfolders"`
+	CreateFoldersForNewUsers  types.Bool     `tfsdk:"create_folders_for_new_users"`
+	AuthType                  types.String   `tfsdk:"auth_type"`
+	Timeouts                  timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *siteResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_site"
+}
+
+func (r *siteResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Globalscape EFT site. Destroying the resource only removes it from Terraform state; the site is kept on the server.",
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+			}),
+		},
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Site identifier assigned by EFT.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Site label. EFT does not support renaming a site through the API, so changing it is rejected at plan time.",
+				Required:            true,
+			},
+			"root_folder": schema.StringAttribute{
+				MarkdownDescription: "Site root folder, for example `C:\\InetPub\\EFTRoot\\MySite\\`. `globalscapeeft_site_general` can also set it; manage it in only one of the two resources.",
+				Required:            true,
+			},
+			"pci_compliance_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the site is created with PCI DSS enforcement enabled. Only applied at creation; changing it later is rejected.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"create_unix_style_subfolders": schema.BoolAttribute{
+				MarkdownDescription: "Whether the standard Unix-style subfolders (`/Bin`, `/Pub`, `/Usr`) are created under the root folder. Only applied at creation; changing it later is rejected.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"create_folders_for_new_users": schema.BoolAttribute{
+				MarkdownDescription: "Whether a home folder is created automatically for new users. Only applied at creation; changing it later is rejected.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"auth_type": schema.StringAttribute{
+				MarkdownDescription: "User authentication provider of the site (for example `EFT`).",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// ModifyPlan rejects changes to the name and the creation-only flags. The API
+// can neither rename nor delete a site, so replacing it would leave the old
+// site on the server next to a new one.
+func (r *siteResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state siteResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, name := range changedCreationOnlyAttributes(&plan, &state) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(name),
+			"Site attribute cannot be changed",
+			fmt.Sprintf("%s is only applied when the site is created and EFT cannot delete a site through the API, so the site cannot be replaced either. Revert the change, or change the site in the EFT administration interface and update the configuration to match.", name),
+		)
+	}
+}
+
+// changedCreationOnlyAttributes lists the attributes among the name and the
+// creation flags whose planned value differs from state. A flag without a
+// stored value, as after an import, is taken from the plan.
+func changedCreationOnlyAttributes(plan, state *siteResourceModel) []string {
+	attributes := []struct {
+		name           string
+		planned, prior attr.Value
+	}{
+		{"name", plan.Name, state.Name},
+		{"pci_compliance_enabled", plan.PCIComplianceEnabled, state.PCIComplianceEnabled},
+		{"create_unix_style_subfolders", plan.CreateUnixStyleSubFolders, state.CreateUnixStyleSubFolders},
+		{"create_folders_for_new_users", plan.CreateFoldersForNewUsers, state.CreateFoldersForNewUsers},
+	}
+
+	var changed []string
+	for _, a := range attributes {
+		if a.prior.IsNull() || a.planned.IsUnknown() {
+			continue
+		}
+		if !a.planned.Equal(a.prior) {
+			changed = append(changed, a.name)
+		}
+	}
+	return changed
+}
+
+func (r *siteResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if c, ok := req.ProviderData.(*client.Client); ok {
+		r.client = c
+	}
+}
+
+func (r *siteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var plan siteResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, 5*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	site, err := r.client.CreateSite(ctx, client.SiteCreateAttributes{
+		SiteLabel:                 plan.Name.ValueString(),
+		SiteRootFolder:            plan.RootFolder.ValueString(),
+		PCIComplianceEnabled:      plan.PCIComplianceEnabled.ValueBool(),
+		CreateUnixStyleSubFolders: plan.CreateUnixStyleSubFolders.ValueBool(),
+		CreateFoldersForNewUsers:  plan.CreateFoldersForNewUsers.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create site", err.Error())
+		return
+	}
+
+	// Save the identifier before reading the site back so a failed read
+	// leaves a tainted resource instead of an untracked site.
+	plan.ID = types.StringValue(site.ID)
+	plan.AuthType = types.StringNull()
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The create response is not guaranteed to carry the full document.
+	site, err = r.client.GetSite(ctx, site.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read site", err.Error())
+		return
+	}
+
+	plan.fromAPI(site)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *siteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var state siteResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, 5*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	site, err := r.client.GetSite(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read site", err.Error())
+		return
+	}
+
+	state.fromAPI(site)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *siteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var plan, state siteResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, 5*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	if !plan.RootFolder.Equal(state.RootFolder) {
+		rootFolder := plan.RootFolder.ValueString()
+		if _, err := r.client.UpdateSiteGeneral(ctx, plan.ID.ValueString(), client.SiteGeneralUpdate{SiteRootFolder: &rootFolder}); err != nil {
+			resp.Diagnostics.AddError("Failed to update site", err.Error())
+			return
+		}
+	}
+
+	site, err := r.client.GetSite(ctx, plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read site", err.Error())
+		return
+	}
+
+	plan.fromAPI(site)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *siteResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The published API has no endpoint to delete a site.
+	// Removing the resource from Terraform state only; the site and its users remain on the server.
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *siteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// fromAPI refreshes the attributes EFT reports. The creation flags are not
+// part of the site document and keep their planned or stored values.
+func (m *siteResourceModel) fromAPI(site *client.Site) {
+	m.ID = types.StringValue(site.ID)
+	m.Name = types.StringValue(site.Attributes.Name)
+	// EFT normalizes the root folder, typically by appending a trailing
+	// backslash, so keep the configured spelling while it is equivalent.
+	if m.RootFolder.IsNull() || m.RootFolder.IsUnknown() || !sameFolderPath(m.RootFolder.ValueString(), site.Attributes.General.RootFolder) {
+		m.RootFolder = types.StringValue(site.Attributes.General.RootFolder)
+	}
+	m.AuthType = types.StringValue(site.Attributes.General.AuthType)
+}
+
+// sameFolderPath reports whether two Windows folder paths refer to the same
+// folder, ignoring case, separator style and a trailing separator.
+func sameFolderPath(a, b string) bool {
+	normalize := func(p string) string {
+		p = strings.ReplaceAll(p, "/", "\\")
+		return strings.TrimRight(p, "\\")
+	}
+	return strings.EqualFold(normalize(a), normalize(b))
+}
@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSameFolderPath(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want bool
+	}{
+		{name: "identical", a: `C:\InetPub\EFTRoot\Partners\`, b: `C:\InetPub\EFTRoot\Partners\`, want: true},
+		{name: "trailing backslash added", a: `C:\InetPub\EFTRoot\Partners`, b: `C:\InetPub\EFTRoot\Partners\`, want: true},
+		{name: "case differs", a: `c:\inetpub\eftroot\partners`, b: `C:\InetPub\EFTRoot\Partners\`, want: true},
+		{name: "forward slashes", a: `C:/InetPub/EFTRoot/Partners/`, b: `C:\InetPub\EFTRoot\Partners\`, want: true},
+		{name: "different folder", a: `C:\InetPub\EFTRoot\Partners`, b: `C:\InetPub\EFTRoot\Vendors\`, want: false},
+		{name: "parent folder", a: `C:\InetPub\EFTRoot`, b: `C:\InetPub\EFTRoot\Partners\`, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sameFolderPath(tt.a, tt.b); got != tt.want {
+				t.Fatalf("sameFolderPath(%q, %q) = %t, want %t", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSiteResourceModelFromAPI_RootFolder(t *testing.T) {
+	site := &client.Site{
+		ID: "892b16dc-24a8-473f-a74e-c597b824c879",
+		Attributes: client.SiteAttributes{
+			Name:    "Partners",
+			General: client.SiteGeneral{AuthType: "EFT", RootFolder: `C:\InetPub\EFTRoot\Partners\`},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		current types.String
+		want    string
+	}{
+		{name: "import", current: types.StringNull(), want: `C:\InetPub\EFTRoot\Partners\`},
+		{name: "equivalent configured value kept", current: types.StringValue(`C:\InetPub\EFTRoot\Partners`), want: `C:\InetPub\EFTRoot\Partners`},
+		{name: "drift detected", current: types.StringValue(`C:\InetPub\EFTRoot\Vendors`), want: `C:\InetPub\EFTRoot\Partners\`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := siteResourceModel{RootFolder: tt.current}
+			m.fromAPI(site)
+			if got := m.RootFolder.ValueString(); got != tt.want {
+				t.Fatalf("root_folder = %q, want %q", got, tt.want)
+			}
+			if m.ID.ValueString() != site.ID || m.AuthType.ValueString() != "EFT" {
+				t.Fatalf("unexpected id %q or auth_type %q", m.ID.ValueString(), m.AuthType.ValueString())
+			}
+		})
+	}
+}
+
+func TestChangedCreationOnlyAttributes(t *testing.T) {
+	state := siteResourceModel{
+		Name:                      types.StringValue("Partners"),
+		PCIComplianceEnabled:      types.BoolValue(false),
+		CreateUnixStyleSubFolders: types.BoolValue(true),
+		CreateFoldersForNewUsers:  types.BoolValue(true),
+	}
+	imported := siteResourceModel{
+		Name:                      types.StringValue("Partners"),
+		PCIComplianceEnabled:      types.BoolNull(),
+		CreateUnixStyleSubFolders: types.BoolNull(),
+		CreateFoldersForNewUsers:  types.BoolNull(),
+	}
+
+	tests := []struct {
+		name  string
+		state siteResourceModel
+		edit  func(m *siteResourceModel)
+		want  []string
+	}{
+		{name: "unchanged", state: state, edit: func(*siteResourceModel) {}},
+		{name: "root folder only", state: state, edit: func(m *siteResourceModel) { m.RootFolder = types.StringValue(`D:\EFT\Partners`) }},
+		{name: "renamed", state: state, edit: func(m *siteResourceModel) { m.Name = types.StringValue("Vendors") }, want: []string{"name"}},
+		{
+			name:  "creation flags changed",
+			state: state,
+			edit: func(m *siteResourceModel) {
+				m.PCIComplianceEnabled = types.BoolValue(true)
+				m.CreateFoldersForNewUsers = types.BoolValue(false)
+			},
+			want: []string{"pci_compliance_enabled", "create_folders_for_new_users"},
+		},
+		{
+			name:  "flags set after import",
+			state: imported,
+			edit: func(m *siteResourceModel) {
+				m.PCIComplianceEnabled = types.BoolValue(true)
+				m.CreateUnixStyleSubFolders = types.BoolValue(false)
+			},
+		},
+		{name: "unknown name", state: state, edit: func(m *siteResourceModel) { m.Name = types.StringUnknown() }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := tt.state
+			tt.edit(&plan)
+			got := changedCreationOnlyAttributes(&plan, &tt.state)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("changedCreationOnlyAttributes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
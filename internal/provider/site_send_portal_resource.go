@@ -0,0 +1,330 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &siteSendPortalResource{}
+var _ resource.ResourceWithConfigure = &siteSendPortalResource{}
+var _ resource.ResourceWithImportState = &siteSendPortalResource{}
+
+func NewSiteSendPortalResource() resource.Resource {
+	return &siteSendPortalResource{}
+}
+
+type siteSendPortalResource struct {
+	client *client.Client
+}
+
+type siteSendPortalResourceModel struct {
+	ID                        types.String `tfsdk:"id"`
+	SiteID                    types.String `tfsdk:"site_id"`
+	Enabled                   types.Bool   `tfsdk:"enabled"`
+	Recipients                types.String `tfsdk:"recipients"`
+	AllowedDomainsEnabled     types.Bool   `tfsdk:"allowed_domains_enabled"`
+	AllowedDomains            types.List   `tfsdk:"allowed_domains"`
+	DeniedDomainsEnabled      types.Bool   `tfsdk:"denied_domains_enabled"`
+	DeniedDomains             types.List   `tfsdk:"denied_domains"`
+	ReservedPath              types.String `tfsdk:"reserved_path"`
+	Protocol                  types.String `tfsdk:"protocol"`
+	HostName                  types.String `tfsdk:"host_name"`
+	Port                      types.Int64  `tfsdk:"port"`
+	OutlookAutoAttachEnabled  types.Bool   `tfsdk:"outlook_auto_attach_enabled"`
+	OutlookAutoAttachMB       types.Int64  `tfsdk:"outlook_auto_attach_threshold_mb"`
+	LinkExpirationValue       types.Int64  `tfsdk:"link_expiration_value"`
+	LinkExpirationUnits       types.String `tfsdk:"link_expiration_units"`
+	RetainFilesValue          types.Int64  `tfsdk:"retain_files_value"`
+	RetainFilesUnits          types.String `tfsdk:"retain_files_units"`
+	AllowRepliesWithFiles     types.Bool   `tfsdk:"allow_replies_with_files"`
+	SendEntireMessageSecurely types.String `tfsdk:"send_entire_message_securely"`
+	OutOfBandPasscode         types.String `tfsdk:"out_of_band_passcode"`
+	SecondVerification        types.String `tfsdk:"second_verification"`
+	FileRequestEnabled        types.Bool   `tfsdk:"file_request_enabled"`
+	FileRequestReservedPath   types.String `tfsdk:"file_request_reserved_path"`
+}
+
+func (r *siteSendPortalResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_site_send_portal"
+}
+
+func (r *siteSendPortalResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	periodUnits := stringvalidator.OneOf("Day", "Week", "Month", "Year")
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the Send portal (ad-hoc file sending) settings of a Globalscape EFT site. Only the configured attributes are sent to the API. Destroying this resource will only remove it from Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Site identifier.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site_id": schema.StringAttribute{
+				MarkdownDescription: "Site identifier whose Send portal is managed.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled":                          optionalComputedBool("Whether users can send files through the Send portal."),
+			"recipients":                       optionalComputedString("Who may receive sent files, e.g. `UsersGuestsAnonymous`.", stringvalidator.LengthAtLeast(1)),
+			"allowed_domains_enabled":          optionalComputedBool("Restrict recipients to `allowed_domains`."),
+			"allowed_domains":                  domainListAttribute("Email domain patterns files may be sent to, e.g. `*.example.com`."),
+			"denied_domains_enabled":           optionalComputedBool("Reject recipients in `denied_domains`."),
+			"denied_domains":                   domainListAttribute("Email domain patterns files may not be sent to."),
+			"reserved_path":                    optionalComputedString("URL path of the Send portal, e.g. `/send`.", stringvalidator.LengthAtLeast(1)),
+			"protocol":                         optionalComputedString("Protocol used in generated links: `http` or `https`.", stringvalidator.OneOf("http", "https")),
+			"host_name":                        optionalComputedString("Host name used in generated links. Empty uses the site address."),
+			"port":                             optionalComputedInt64("Port used in generated links.", int64validator.Between(1, 65535)),
+			"outlook_auto_attach_enabled":      optionalComputedBool("Whether the Outlook add-in sends large attachments through the portal automatically."),
+			"outlook_auto_attach_threshold_mb": optionalComputedInt64("Attachment size, in MB, above which the Outlook add-in uses the portal.", int64validator.AtLeast(1)),
+			"link_expiration_value":            optionalComputedInt64("Lifetime of download links, in `link_expiration_units`.", int64validator.AtLeast(1)),
+			"link_expiration_units":            optionalComputedString("Unit of `link_expiration_value`: `Day`, `Week`, `Month` or `Year`.", periodUnits),
+			"retain_files_value":               optionalComputedInt64("How long sent files are kept after their links expire, in `retain_files_units`. `0` deletes them immediately.", int64validator.AtLeast(0)),
+			"retain_files_units":               optionalComputedString("Unit of `retain_files_value`: `Day`, `Week`, `Month` or `Year`.", periodUnits),
+			"allow_replies_with_files":         optionalComputedBool("Whether recipients can reply with files."),
+			"send_entire_message_securely":     optionalComputedString("Whether the message body is only available through the portal, e.g. `LetUserChoose`.", stringvalidator.LengthAtLeast(1)),
+			"out_of_band_passcode":             optionalComputedString("Passcode policy for downloads, e.g. `Sender chooses`.", stringvalidator.LengthAtLeast(1)),
+			"second_verification":              optionalComputedString("Second verification required from recipients, e.g. `email`.", stringvalidator.LengthAtLeast(1)),
+			"file_request_enabled":             optionalComputedBool("Whether users can request files through the File Request portal."),
+			"file_request_reserved_path":       optionalComputedString("URL path of the File Request portal, e.g. `/request`.", stringvalidator.LengthAtLeast(1)),
+		},
+	}
+}
+
+func (r *siteSendPortalResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if c, ok := req.ProviderData.(*client.Client); ok {
+		r.client = c
+	}
+}
+
+func (r *siteSendPortalResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var plan siteSendPortalResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	update, diags := plan.toAPIModel(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.UpdateSiteSendPortal(ctx, plan.SiteID.ValueString(), update)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update site Send portal settings", err.Error())
+		return
+	}
+
+	newState, diags := fromSiteSendPortal(ctx, settings)
+	resp.Diagnostics.Append(diags...)
+	newState.ID = plan.SiteID
+	newState.SiteID = plan.SiteID
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *siteSendPortalResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var state siteSendPortalResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	siteID := state.SiteID
+	if siteID.IsNull() {
+		siteID = state.ID
+	}
+
+	settings, err := r.client.GetSiteSendPortal(ctx, siteID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read site Send portal settings", err.Error())
+		return
+	}
+
+	newState, diags := fromSiteSendPortal(ctx, settings)
+	resp.Diagnostics.Append(diags...)
+	newState.ID = siteID
+	newState.SiteID = siteID
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *siteSendPortalResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var plan siteSendPortalResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	update, diags := plan.toAPIModel(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.UpdateSiteSendPortal(ctx, plan.SiteID.ValueString(), update)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update site Send portal settings", err.Error())
+		return
+	}
+
+	newState, diags := fromSiteSendPortal(ctx, settings)
+	resp.Diagnostics.Append(diags...)
+	newState.ID = plan.ID
+	newState.SiteID = plan.SiteID
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *siteSendPortalResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The Send portal is part of the site and cannot be deleted via the API.
+	// Removing the resource from Terraform state only; the site keeps its configuration.
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *siteSendPortalResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site_id"), req.ID)...)
+}
+
+// toAPIModel only populates the fields that are known in the plan so the PATCH
+// body stays partial.
+func (m *siteSendPortalResourceModel) toAPIModel(ctx context.Context) (client.SiteSendPortal, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	settings := client.SiteSendPortal{
+		Enabled:                   boolPointer(m.Enabled),
+		Recipients:                stringPointer(m.Recipients),
+		AllowedDomains:            domainFilterToAPI(ctx, m.AllowedDomainsEnabled, m.AllowedDomains, &diags),
+		DeniedDomains:             domainFilterToAPI(ctx, m.DeniedDomainsEnabled, m.DeniedDomains, &diags),
+		ReservedPath:              stringPointer(m.ReservedPath),
+		Protocol:                  stringPointer(m.Protocol),
+		HostName:                  stringPointer(m.HostName),
+		LinkExpiration:            expirationPeriodToAPI(m.LinkExpirationValue, m.LinkExpirationUnits),
+		AllowRepliesWithFiles:     boolPointer(m.AllowRepliesWithFiles),
+		SendEntireMessageSecurely: stringPointer(m.SendEntireMessageSecurely),
+		OutOfBandPasscode:         stringPointer(m.OutOfBandPasscode),
+		SecondVerification:        stringPointer(m.SecondVerification),
+	}
+
+	// EFT models the link port as a string.
+	if port := int64Pointer(m.Port); port != nil {
+		value := strconv.FormatInt(*port, 10)
+		settings.Port = &value
+	}
+
+	outlook := client.OutlookAutoAttach{
+		Enabled:          boolPointer(m.OutlookAutoAttachEnabled),
+		WhenExceedMbytes: int64Pointer(m.OutlookAutoAttachMB),
+	}
+	if outlook.Enabled != nil || outlook.WhenExceedMbytes != nil {
+		settings.AutoAttachInOutlook = &outlook
+	}
+
+	retain := client.RetentionPeriod{
+		Value: int64Pointer(m.RetainFilesValue),
+		Unit:  stringPointer(m.RetainFilesUnits),
+	}
+	if retain.Value != nil || retain.Unit != nil {
+		settings.RetainFiles = &retain
+	}
+
+	fileRequest := client.FileRequestPortal{
+		Enabled:      boolPointer(m.FileRequestEnabled),
+		ReservedPath: stringPointer(m.FileRequestReservedPath),
+	}
+	if fileRequest.Enabled != nil || fileRequest.ReservedPath != nil {
+		settings.FileRequestPortal = &fileRequest
+	}
+
+	return settings, diags
+}
+
+func fromSiteSendPortal(ctx context.Context, settings *client.SiteSendPortal) (*siteSendPortalResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	m := &siteSendPortalResourceModel{
+		Enabled:                   boolFromPointer(settings.Enabled),
+		Recipients:                stringFromPointer(settings.Recipients),
+		ReservedPath:              stringFromPointer(settings.ReservedPath),
+		Protocol:                  stringFromPointer(settings.Protocol),
+		HostName:                  stringFromPointer(settings.HostName),
+		Port:                      types.Int64Null(),
+		AllowRepliesWithFiles:     boolFromPointer(settings.AllowRepliesWithFiles),
+		SendEntireMessageSecurely: stringFromPointer(settings.SendEntireMessageSecurely),
+		OutOfBandPasscode:         stringFromPointer(settings.OutOfBandPasscode),
+		SecondVerification:        stringFromPointer(settings.SecondVerification),
+	}
+
+	m.AllowedDomainsEnabled, m.AllowedDomains = domainFilterFromAPI(ctx, settings.AllowedDomains, &diags)
+	m.DeniedDomainsEnabled, m.DeniedDomains = domainFilterFromAPI(ctx, settings.DeniedDomains, &diags)
+
+	if settings.Port != nil && *settings.Port != "" {
+		port, err := strconv.ParseInt(*settings.Port, 10, 64)
+		if err != nil {
+			diags.AddError("Unexpected Send portal port", fmt.Sprintf("EFT returned port %q: %s", *settings.Port, err))
+		} else {
+			m.Port = types.Int64Value(port)
+		}
+	}
+
+	outlook := settings.AutoAttachInOutlook
+	if outlook == nil {
+		outlook = &client.OutlookAutoAttach{}
+	}
+	m.OutlookAutoAttachEnabled = boolFromPointer(outlook.Enabled)
+	m.OutlookAutoAttachMB = int64FromPointer(outlook.WhenExceedMbytes)
+
+	linkExpiration := settings.LinkExpiration
+	if linkExpiration == nil {
+		linkExpiration = &client.ExpirationPeriod{}
+	}
+	m.LinkExpirationValue = int64FromPointer(linkExpiration.Value)
+	m.LinkExpirationUnits = stringFromPointer(linkExpiration.Units)
+
+	retain := settings.RetainFiles
+	if retain == nil {
+		retain = &client.RetentionPeriod{}
+	}
+	m.RetainFilesValue = int64FromPointer(retain.Value)
+	m.RetainFilesUnits = stringFromPointer(retain.Unit)
+
+	fileRequest := settings.FileRequestPortal
+	if fileRequest == nil {
+		fileRequest = &client.FileRequestPortal{}
+	}
+	m.FileRequestEnabled = boolFromPointer(fileRequest.Enabled)
+	m.FileRequestReservedPath = stringFromPointer(fileRequest.ReservedPath)
+
+	return m, diags
+}
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSiteSendPortalToAPIModel(t *testing.T) {
+	ctx := context.Background()
+
+	m, diags := fromSiteSendPortal(ctx, &client.SiteSendPortal{})
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+	m.Enabled = types.BoolValue(true)
+	m.Port = types.Int64Value(8443)
+	m.RetainFilesValue = types.Int64Value(30)
+	m.FileRequestEnabled = types.BoolValue(false)
+
+	settings, diags := m.toAPIModel(ctx)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+	body, _ := json.Marshal(settings)
+	want := `{"enabled":true,"port":"8443","retainFilesAfterLinkExpiration":{"value":30},"fileRequestPortal":{"enabled":false}}`
+	if string(body) != want {
+		t.Errorf("toAPIModel() =\n%s\nwant\n%s", body, want)
+	}
+}
+
+func TestFromSiteSendPortal(t *testing.T) {
+	tests := []struct {
+		name      string
+		body      string
+		wantPort  types.Int64
+		wantError bool
+	}{
+		{name: "no port", body: `{"enabled":true}`, wantPort: types.Int64Null()},
+		{name: "empty port", body: `{"port":""}`, wantPort: types.Int64Null()},
+		{name: "string port", body: `{"port":"443"}`, wantPort: types.Int64Value(443)},
+		{name: "invalid port", body: `{"port":"https"}`, wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var settings client.SiteSendPortal
+			if err := json.Unmarshal([]byte(tt.body), &settings); err != nil {
+				t.Fatalf("unmarshal: %v", err)
+			}
+
+			m, diags := fromSiteSendPortal(context.Background(), &settings)
+			if diags.HasError() != tt.wantError {
+				t.Fatalf("errors = %v, want error %t", diags, tt.wantError)
+			}
+			if tt.wantError {
+				return
+			}
+			if !m.Port.Equal(tt.wantPort) {
+				t.Errorf("port = %s, want %s", m.Port, tt.wantPort)
+			}
+			if !m.RetainFilesValue.IsNull() || !m.FileRequestEnabled.IsNull() {
+				t.Errorf("missing sections not null: %+v", m)
+			}
+		})
+	}
+}
@@ -0,0 +1,375 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &siteSMSProfileResource{}
+var _ resource.ResourceWithConfigure = &siteSMSProfileResource{}
+var _ resource.ResourceWithImportState = &siteSMSProfileResource{}
+
+const smsServiceTypeGeneric = "genericSmsProviderProfile"
+
+func NewSiteSMSProfileResource() resource.Resource {
+	return &siteSMSProfileResource{}
+}
+
+type siteSMSProfileResource struct {
+	client *client.Client
+}
+
+type siteSMSProfileResourceModel struct {
+	ID                 types.String   `tfsdk:"id"`
+	SiteID             types.String   `tfsdk:"site_id"`
+	Name               types.String   `tfsdk:"name"`
+	ServiceType        types.String   `tfsdk:"service_type"`
+	AccountSid         types.String   `tfsdk:"account_sid"`
+	AuthToken          types.String   `tfsdk:"auth_token"`
+	CredentialsVersion types.Int64    `tfsdk:"credentials_version"`
+	SenderNumber       types.String   `tfsdk:"sender_number"`
+	Message            types.String   `tfsdk:"message"`
+	PostURL            types.String   `tfsdk:"post_url"`
+	Proxy              *smsProxyModel `tfsdk:"proxy"`
+}
+
+type smsProxyModel struct {
+	Enabled  types.Bool   `tfsdk:"enabled"`
+	Type     types.String `tfsdk:"type"`
+	HostName types.String `tfsdk:"host_name"`
+	Port     types.Int64  `tfsdk:"port"`
+	UserName types.String `tfsdk:"user_name"`
+	Password types.String `tfsdk:"password"`
+}
+
+func (r *siteSMSProfileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_site_sms_profile"
+}
+
+func (r *siteSMSProfileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an SMS gateway profile used for two-factor authentication on a Globalscape EFT site.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier in the form `<site_id>/<name>`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site_id": schema.StringAttribute{
+				MarkdownDescription: "Site identifier the profile belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Profile name. Changing it forces a new resource.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"service_type": schema.StringAttribute{
+				MarkdownDescription: "Gateway type sent to EFT. Defaults to `genericSmsProviderProfile`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(smsServiceTypeGeneric),
+			},
+			"account_sid": schema.StringAttribute{
+				MarkdownDescription: "Account identifier at the SMS provider, e.g. the Twilio account SID.",
+				Required:            true,
+			},
+			"auth_token": schema.StringAttribute{
+				MarkdownDescription: "Authentication token at the SMS provider. Write-only: it is never stored in state. Change `credentials_version` to send a new value.",
+				Required:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"credentials_version": schema.Int64Attribute{
+				MarkdownDescription: "Arbitrary number that triggers sending `auth_token` and the proxy `password` again when changed.",
+				Optional:            true,
+			},
+			"sender_number": schema.StringAttribute{
+				MarkdownDescription: "Phone number messages are sent from.",
+				Required:            true,
+			},
+			"message": schema.StringAttribute{
+				MarkdownDescription: "Message body. `%Account_Session_OTP%` is replaced with the passcode.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"post_url": schema.StringAttribute{
+				MarkdownDescription: "URL the message is posted to. `%SID%` is replaced with `account_sid`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"proxy": schema.SingleNestedBlock{
+				MarkdownDescription: "Proxy used to reach the SMS provider. Not managed when omitted.",
+				Attributes: map[string]schema.Attribute{
+					"enabled": optionalComputedBool("Whether the proxy is used."),
+					"type": schema.StringAttribute{
+						MarkdownDescription: "Proxy type as reported by EFT.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"host_name": schema.StringAttribute{
+						MarkdownDescription: "Proxy host name or IP address.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"port": optionalComputedInt64("Proxy port.", int64validator.Between(1, 65535)),
+					"user_name": schema.StringAttribute{
+						MarkdownDescription: "Proxy user name.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"password": schema.StringAttribute{
+						MarkdownDescription: "Proxy password. Write-only: it is never stored in state.",
+						Optional:            true,
+						Sensitive:           true,
+						WriteOnly:           true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *siteSMSProfileResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if c, ok := req.ProviderData.(*client.Client); ok {
+		r.client = c
+	}
+}
+
+func (r *siteSMSProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var plan siteSMSProfileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	authToken, proxyPassword, diags := smsProfileSecrets(ctx, req.Config, plan.Proxy != nil)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attrs := plan.toAPIModel(authToken, proxyPassword)
+	attrs.Name = plan.Name.ValueString()
+	profile, err := r.client.CreateSiteSMSProfile(ctx, plan.SiteID.ValueString(), attrs)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create SMS profile", err.Error())
+		return
+	}
+
+	plan.fromAPI(profile)
+	plan.ID = types.StringValue(fmt.Sprintf("%s/%s", plan.SiteID.ValueString(), plan.Name.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *siteSMSProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var state siteSMSProfileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profiles, err := r.client.ListSiteSMSProfiles(ctx, state.SiteID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read SMS profiles", err.Error())
+		return
+	}
+
+	for i := range profiles {
+		if profiles[i].ID == state.Name.ValueString() {
+			state.fromAPI(&profiles[i])
+			if state.ServiceType.IsNull() {
+				state.ServiceType = types.StringValue(smsServiceTypeGeneric)
+			}
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *siteSMSProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var plan, state siteSMSProfileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write-only values cannot be compared with state, so the credentials are
+	// only resent when credentials_version changes or the proxy block is new.
+	var authToken, proxyPassword string
+	if !plan.CredentialsVersion.Equal(state.CredentialsVersion) || (plan.Proxy != nil && state.Proxy == nil) {
+		var diags diag.Diagnostics
+		authToken, proxyPassword, diags = smsProfileSecrets(ctx, req.Config, plan.Proxy != nil)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	profile, err := r.client.UpdateSiteSMSProfile(ctx, plan.SiteID.ValueString(), plan.Name.ValueString(), plan.toAPIModel(authToken, proxyPassword))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update SMS profile", err.Error())
+		return
+	}
+
+	plan.fromAPI(profile)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *siteSMSProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var state siteSMSProfileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteSiteSMSProfile(ctx, state.SiteID.ValueString(), state.Name.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Failed to delete SMS profile", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *siteSMSProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	siteID, name, ok := strings.Cut(req.ID, "/")
+	if !ok || siteID == "" || name == "" {
+		resp.Diagnostics.AddError("Invalid import identifier", "Expected identifier in the form <site_id>/<profile_name>")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site_id"), siteID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
+
+// smsProfileSecrets reads the write-only credentials from configuration.
+func smsProfileSecrets(ctx context.Context, config tfsdk.Config, withProxy bool) (string, string, diag.Diagnostics) {
+	var authToken, proxyPassword types.String
+	diags := config.GetAttribute(ctx, path.Root("auth_token"), &authToken)
+	if withProxy {
+		diags.Append(config.GetAttribute(ctx, path.Root("proxy").AtName("password"), &proxyPassword)...)
+	}
+	return stringValueOrEmpty(authToken), stringValueOrEmpty(proxyPassword), diags
+}
+
+func (m *siteSMSProfileResourceModel) toAPIModel(authToken, proxyPassword string) client.SMSProfileAttributes {
+	attrs := client.SMSProfileAttributes{
+		ServiceType: stringValueOrEmpty(m.ServiceType),
+		Services: client.SMSServices{
+			AccountSid:   m.AccountSid.ValueString(),
+			AuthToken:    authToken,
+			TwilioNumber: m.SenderNumber.ValueString(),
+			Message:      stringValueOrEmpty(m.Message),
+			PostURL:      stringValueOrEmpty(m.PostURL),
+		},
+	}
+
+	if p := m.Proxy; p != nil {
+		attrs.Proxy = &client.SMSProxy{
+			Enabled:  p.Enabled.ValueBool(),
+			Type:     stringValueOrEmpty(p.Type),
+			HostName: stringValueOrEmpty(p.HostName),
+			Port:     int64ValueOrZero(p.Port),
+			UserName: stringValueOrEmpty(p.UserName),
+			Password: proxyPassword,
+		}
+	}
+
+	return attrs
+}
+
+// fromAPI leaves service_type alone because EFT reports its own identifier
+// (e.g. twilioGeneric) rather than the value that was sent.
+func (m *siteSMSProfileResourceModel) fromAPI(profile *client.SMSProfile) {
+	s := profile.Attributes.Services
+	m.AccountSid = types.StringValue(s.AccountSid)
+	m.AuthToken = types.StringNull()
+	m.SenderNumber = types.StringValue(s.TwilioNumber)
+	m.Message = types.StringValue(s.Message)
+	m.PostURL = types.StringValue(s.PostURL)
+
+	if m.Proxy != nil {
+		p := profile.Attributes.Proxy
+		if p == nil {
+			p = &client.SMSProxy{}
+		}
+		m.Proxy = &smsProxyModel{
+			Enabled:  types.BoolValue(p.Enabled),
+			Type:     types.StringValue(p.Type),
+			HostName: types.StringValue(p.HostName),
+			Port:     types.Int64Value(p.Port),
+			UserName: types.StringValue(p.UserName),
+			Password: types.StringNull(),
+		}
+	}
+}
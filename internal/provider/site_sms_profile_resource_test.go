@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+)
+
+func TestSiteSMSProfileToAPIModel(t *testing.T) {
+	base := siteSMSProfileResourceModel{
+		ServiceType:  types.StringValue(smsServiceTypeGeneric),
+		AccountSid:   types.StringValue("AC01"),
+		SenderNumber: types.StringValue("+12105550100"),
+		Message:      types.StringNull(),
+		PostURL:      types.StringNull(),
+	}
+	withProxy := base
+	withProxy.Proxy = &smsProxyModel{
+		Enabled:  types.BoolValue(true),
+		Type:     types.StringValue("HTTP"),
+		HostName: types.StringValue("proxy.example.com"),
+		Port:     types.Int64Value(8080),
+		UserName: types.StringNull(),
+		Password: types.StringNull(),
+	}
+
+	tests := []struct {
+		name          string
+		model         siteSMSProfileResourceModel
+		authToken     string
+		proxyPassword string
+		want          string
+	}{
+		{
+			name:  "credentials unchanged are omitted",
+			model: base,
+			want:  `{"serviceType":"genericSmsProviderProfile","services":{"accountSid":"AC01","twilioNumber":"+12105550100"}}`,
+		},
+		{
+			name:      "new auth token sent",
+			model:     base,
+			authToken: "secret",
+			want:      `{"serviceType":"genericSmsProviderProfile","services":{"accountSid":"AC01","authToken":"secret","twilioNumber":"+12105550100"}}`,
+		},
+		{
+			name:          "proxy with password",
+			model:         withProxy,
+			proxyPassword: "proxy-secret",
+			want:          `{"serviceType":"genericSmsProviderProfile","services":{"accountSid":"AC01","twilioNumber":"+12105550100"},"proxy":{"enabled":true,"type":"HTTP","hostName":"proxy.example.com","port":8080,"password":"proxy-secret"}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.model.toAPIModel(tt.authToken, tt.proxyPassword))
+			if err != nil {
+				t.Fatalf("marshal: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("toAPIModel() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSiteSMSProfileFromAPI(t *testing.T) {
+	profile := &client.SMSProfile{ID: "Twilio", Attributes: client.SMSProfileAttributes{
+		ServiceType: "twilioGeneric",
+		Services: client.SMSServices{
+			AccountSid:   "AC01",
+			TwilioNumber: "+12105550100",
+			Message:      "Your code is %CODE%",
+		},
+		Proxy: &client.SMSProxy{Enabled: true, HostName: "proxy.example.com", Port: 8080},
+	}}
+
+	t.Run("proxy not configured", func(t *testing.T) {
+		m := siteSMSProfileResourceModel{
+			ServiceType: types.StringValue(smsServiceTypeGeneric),
+			AuthToken:   types.StringValue("secret"),
+		}
+		m.fromAPI(profile)
+
+		if m.Proxy != nil {
+			t.Errorf("proxy = %+v, want nil when not configured", m.Proxy)
+		}
+		if !m.AuthToken.IsNull() {
+			t.Errorf("auth_token = %s, want null", m.AuthToken)
+		}
+		if m.ServiceType.ValueString() != smsServiceTypeGeneric {
+			t.Errorf("service_type = %s, want the configured value", m.ServiceType)
+		}
+		if m.AccountSid.ValueString() != "AC01" || m.SenderNumber.ValueString() != "+12105550100" || m.Message.ValueString() != "Your code is %CODE%" {
+			t.Errorf("services not read back: %+v", m)
+		}
+	})
+
+	t.Run("proxy configured", func(t *testing.T) {
+		m := siteSMSProfileResourceModel{Proxy: &smsProxyModel{Password: types.StringValue("proxy-secret")}}
+		m.fromAPI(profile)
+
+		if m.Proxy == nil {
+			t.Fatal("proxy = nil, want it read back")
+		}
+		if !m.Proxy.Enabled.ValueBool() || m.Proxy.HostName.ValueString() != "proxy.example.com" || m.Proxy.Port.ValueInt64() != 8080 {
+			t.Errorf("proxy = %+v", m.Proxy)
+		}
+		if !m.Proxy.Password.IsNull() {
+			t.Errorf("proxy password = %s, want null", m.Proxy.Password)
+		}
+	})
+
+	t.Run("proxy removed on server", func(t *testing.T) {
+		m := siteSMSProfileResourceModel{Proxy: &smsProxyModel{}}
+		m.fromAPI(&client.SMSProfile{ID: "Twilio"})
+
+		if m.Proxy == nil || m.Proxy.Enabled.ValueBool() || m.Proxy.HostName.ValueString() != "" {
+			t.Errorf("proxy = %+v, want an empty disabled proxy", m.Proxy)
+		}
+	})
+}
@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &siteTemplateResource{}
+var _ resource.ResourceWithConfigure = &siteTemplateResource{}
+var _ resource.ResourceWithImportState = &siteTemplateResource{}
+var _ resource.ResourceWithModifyPlan = &siteTemplateResource{}
+
+func NewSiteTemplateResource() resource.Resource {
+	return &siteTemplateResource{}
+}
+
+type siteTemplateResource struct {
+	client *client.Client
+}
+
+type siteTemplateResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	SiteID        types.String `tfsdk:"site_id"`
+	TemplateName  types.String `tfsdk:"template_name"`
+	Source        types.String `tfsdk:"source"`
+	Content       types.String `tfsdk:"content"`
+	ContentSHA256 types.String `tfsdk:"content_sha256"`
+}
+
+func (r *siteTemplateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_site_template"
+}
+
+func (r *siteTemplateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := templateContentAttributes()
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "Identifier in the form `<site_id>/<template_name>`.",
+		Computed:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["site_id"] = schema.StringAttribute{
+		MarkdownDescription: "Site identifier that owns the template.",
+		Required:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["template_name"] = schema.StringAttribute{
+		MarkdownDescription: "Template file name, for example `WorkspacesAdminAddedParticipantMsg.html`.",
+		Required:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the content of a Globalscape EFT site notification template. Requires EFT 8.1.0 or later. Templates cannot be deleted, so destroying this resource only removes it from Terraform state.",
+		Attributes:          attributes,
+	}
+}
+
+func (r *siteTemplateResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if c, ok := req.ProviderData.(*client.Client); ok {
+		r.client = c
+	}
+}
+
+func (r *siteTemplateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan siteTemplateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hash, diags := plannedTemplateHash(plan.Source, plan.Content)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_sha256"), hash)...)
+}
+
+func (r *siteTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var plan siteTemplateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	content, err := readTemplateContent(plan.Source, plan.Content)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read template content", err.Error())
+		return
+	}
+
+	if err := r.client.UpdateSiteTemplate(ctx, plan.SiteID.ValueString(), plan.TemplateName.ValueString(), content); err != nil {
+		resp.Diagnostics.AddError("Failed to update site template", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(plan.SiteID.ValueString() + "/" + plan.TemplateName.ValueString())
+	plan.ContentSHA256 = types.StringValue(templateHash(content))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *siteTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var state siteTemplateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	content, err := r.client.GetSiteTemplate(ctx, state.SiteID.ValueString(), state.TemplateName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read site template", err.Error())
+		return
+	}
+
+	state.ContentSHA256 = types.StringValue(templateHash(content))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *siteTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var plan siteTemplateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	content, err := readTemplateContent(plan.Source, plan.Content)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read template content", err.Error())
+		return
+	}
+
+	if err := r.client.UpdateSiteTemplate(ctx, plan.SiteID.ValueString(), plan.TemplateName.ValueString(), content); err != nil {
+		resp.Diagnostics.AddError("Failed to update site template", err.Error())
+		return
+	}
+
+	plan.ContentSHA256 = types.StringValue(templateHash(content))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *siteTemplateResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Templates cannot be deleted via the API. Removing the resource from
+	// Terraform state only; the last applied content remains on the site.
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *siteTemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError("Invalid import identifier", "Expected identifier in the form <site_id>/<template_name>")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("template_name"), parts[1])...)
+}
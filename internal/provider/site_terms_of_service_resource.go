@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &siteTermsOfServiceResource{}
+var _ resource.ResourceWithConfigure = &siteTermsOfServiceResource{}
+var _ resource.ResourceWithImportState = &siteTermsOfServiceResource{}
+
+func NewSiteTermsOfServiceResource() resource.Resource {
+	return &siteTermsOfServiceResource{}
+}
+
+type siteTermsOfServiceResource struct {
+	client *client.Client
+}
+
+type siteTermsOfServiceResourceModel struct {
+	ID                      types.String `tfsdk:"id"`
+	SiteID                  types.String `tfsdk:"site_id"`
+	Type                    types.String `tfsdk:"type"`
+	PreventSftpsUntilAgreed types.Bool   `tfsdk:"prevent_sftps_until_agreed"`
+	AgreementPath           types.String `tfsdk:"agreement_path"`
+	AgreementLabel          types.String `tfsdk:"agreement_label"`
+	EffectiveDate           types.String `tfsdk:"effective_date"`
+}
+
+func (r *siteTermsOfServiceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_site_terms_of_service"
+}
+
+func (r *siteTermsOfServiceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the terms of service that users of a Globalscape EFT site must agree to. Only the configured attributes are sent to the API. Destroying this resource will only remove it from Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Site identifier.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site_id": schema.StringAttribute{
+				MarkdownDescription: "Site identifier whose terms of service are managed.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type":                       optionalComputedString("How agreement is collected, e.g. `Mandatory` or `Disabled`.", stringvalidator.LengthAtLeast(1)),
+			"prevent_sftps_until_agreed": optionalComputedBool("Refuse SFTP and FTPS transfers until the user has agreed."),
+			"agreement_path":             optionalComputedString("Path, on the EFT server, of the terms of service document shown to users."),
+			"agreement_label":            optionalComputedString("Label of the agreement checkbox."),
+			"effective_date": schema.StringAttribute{
+				MarkdownDescription: "Date from which the current terms apply. EFT sets it when the terms change.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *siteTermsOfServiceResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if c, ok := req.ProviderData.(*client.Client); ok {
+		r.client = c
+	}
+}
+
+func (r *siteTermsOfServiceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var plan siteTermsOfServiceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	terms, err := r.client.UpdateSiteTermsOfService(ctx, plan.SiteID.ValueString(), plan.toAPIModel())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update site terms of service", err.Error())
+		return
+	}
+
+	newState := fromSiteTermsOfService(terms)
+	newState.ID = plan.SiteID
+	newState.SiteID = plan.SiteID
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *siteTermsOfServiceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var state siteTermsOfServiceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	siteID := state.SiteID
+	if siteID.IsNull() {
+		siteID = state.ID
+	}
+
+	terms, err := r.client.GetSiteTermsOfService(ctx, siteID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read site terms of service", err.Error())
+		return
+	}
+
+	newState := fromSiteTermsOfService(terms)
+	newState.ID = siteID
+	newState.SiteID = siteID
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *siteTermsOfServiceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var plan siteTermsOfServiceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	terms, err := r.client.UpdateSiteTermsOfService(ctx, plan.SiteID.ValueString(), plan.toAPIModel())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update site terms of service", err.Error())
+		return
+	}
+
+	newState := fromSiteTermsOfService(terms)
+	newState.ID = plan.ID
+	newState.SiteID = plan.SiteID
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *siteTermsOfServiceResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The terms of service are part of the site and cannot be deleted via the API.
+	// Removing the resource from Terraform state only; the site keeps its configuration.
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *siteTermsOfServiceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site_id"), req.ID)...)
+}
+
+func (m *siteTermsOfServiceResourceModel) toAPIModel() client.SiteAgreement {
+	terms := client.SiteAgreement{
+		Type:           stringPointer(m.Type),
+		AgreementPath:  stringPointer(m.AgreementPath),
+		AgreementLabel: stringPointer(m.AgreementLabel),
+	}
+
+	if prevent := boolPointer(m.PreventSftpsUntilAgreed); prevent != nil {
+		terms.Values = &client.AgreementValues{PreventSftpsUntilAgreed: prevent}
+	}
+
+	return terms
+}
+
+func fromSiteTermsOfService(terms *client.SiteAgreement) *siteTermsOfServiceResourceModel {
+	values := terms.Values
+	if values == nil {
+		values = &client.AgreementValues{}
+	}
+
+	return &siteTermsOfServiceResourceModel{
+		Type:                    stringFromPointer(terms.Type),
+		PreventSftpsUntilAgreed: boolFromPointer(values.PreventSftpsUntilAgreed),
+		AgreementPath:           stringFromPointer(terms.AgreementPath),
+		AgreementLabel:          stringFromPointer(terms.AgreementLabel),
+		EffectiveDate:           stringFromPointer(terms.EffectiveDate),
+	}
+}
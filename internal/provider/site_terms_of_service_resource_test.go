@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSiteTermsOfServiceToAPIModel(t *testing.T) {
+	tests := []struct {
+		name    string
+		prevent types.Bool
+		want    string
+	}{
+		{name: "prevent unknown", prevent: types.BoolUnknown(), want: `{"type":"Mandatory"}`},
+		{name: "prevent set", prevent: types.BoolValue(false), want: `{"type":"Mandatory","values":{"preventSftpsUntilAgreed":false}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := siteTermsOfServiceResourceModel{
+				Type:                    types.StringValue("Mandatory"),
+				PreventSftpsUntilAgreed: tt.prevent,
+				AgreementPath:           types.StringNull(),
+				AgreementLabel:          types.StringUnknown(),
+			}
+			body, _ := json.Marshal(m.toAPIModel())
+			if string(body) != tt.want {
+				t.Errorf("toAPIModel() = %s, want %s", body, tt.want)
+			}
+		})
+	}
+}
+
+func TestFromSiteTermsOfService(t *testing.T) {
+	prevent := true
+	path, date := "/Terms.html", "2025-05-01"
+	m := fromSiteTermsOfService(&client.SiteAgreement{
+		Values:        &client.AgreementValues{PreventSftpsUntilAgreed: &prevent},
+		AgreementPath: &path,
+		EffectiveDate: &date,
+	})
+
+	if !m.PreventSftpsUntilAgreed.ValueBool() || m.AgreementPath.ValueString() != path || m.EffectiveDate.ValueString() != date {
+		t.Errorf("terms not read back: %+v", m)
+	}
+	if !m.Type.IsNull() || !m.AgreementLabel.IsNull() {
+		t.Errorf("missing fields not null: %+v", m)
+	}
+}
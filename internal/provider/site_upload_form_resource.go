@@ -0,0 +1,414 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &siteUploadFormResource{}
+var _ resource.ResourceWithConfigure = &siteUploadFormResource{}
+var _ resource.ResourceWithImportState = &siteUploadFormResource{}
+var _ resource.ResourceWithValidateConfig = &siteUploadFormResource{}
+
+// uploadFormOptionPattern rejects commas, which EFT uses to separate the
+// options of a field.
+var uploadFormOptionPattern = regexp.MustCompile(`^[^,]*$`)
+
+func NewSiteUploadFormResource() resource.Resource {
+	return &siteUploadFormResource{}
+}
+
+type siteUploadFormResource struct {
+	client *client.Client
+}
+
+type siteUploadFormResourceModel struct {
+	ID              types.String               `tfsdk:"id"`
+	SiteID          types.String               `tfsdk:"site_id"`
+	Name            types.String               `tfsdk:"name"`
+	DisplayName     types.String               `tfsdk:"display_name"`
+	Description     types.String               `tfsdk:"description"`
+	Instructions    types.String               `tfsdk:"instructions"`
+	Enabled         types.Bool                 `tfsdk:"enabled"`
+	ShowForEachFile types.Bool                 `tfsdk:"show_for_each_file"`
+	PermissionGroup types.String               `tfsdk:"permission_group_id"`
+	Fields          []siteUploadFormFieldModel `tfsdk:"field"`
+}
+
+type siteUploadFormFieldModel struct {
+	Name     types.String   `tfsdk:"name"`
+	Label    types.String   `tfsdk:"label"`
+	Type     types.String   `tfsdk:"type"`
+	Required types.Bool     `tfsdk:"required"`
+	Options  []types.String `tfsdk:"options"`
+}
+
+func (r *siteUploadFormResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_site_upload_form"
+}
+
+func (r *siteUploadFormResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an upload form whose fields users of a Globalscape EFT site fill in when uploading files through the web transfer client.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier in the form `<site_id>/<name>`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site_id": schema.StringAttribute{
+				MarkdownDescription: "Site identifier that owns the form.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Unique form name. Changing it forces a new form.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				MarkdownDescription: "Title shown to users above the form.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Administrator description of the form.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"instructions": schema.StringAttribute{
+				MarkdownDescription: "Instructions shown to users filling in the form.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the form is shown on upload. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"show_for_each_file": schema.BoolAttribute{
+				MarkdownDescription: "Ask for the form once per uploaded file instead of once per upload. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"permission_group_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the permission group whose members are asked to fill in the form. Removing it clears the group in EFT.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"field": schema.ListNestedBlock{
+				MarkdownDescription: "Form fields in display order.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Field name, unique within the form.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"label": schema.StringAttribute{
+							MarkdownDescription: "Label shown next to the field. Defaults to `name`.",
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Field type, e.g. `String`. Compared case-insensitively with the value stored by EFT.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"required": schema.BoolAttribute{
+							MarkdownDescription: "Whether the field must be filled in. Defaults to `false`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+						"options": schema.ListAttribute{
+							MarkdownDescription: "Values offered for the field. Options must not contain commas.",
+							ElementType:         types.StringType,
+							Optional:            true,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+								listvalidator.ValueStringsAre(stringvalidator.RegexMatches(uploadFormOptionPattern, "must not contain commas")),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *siteUploadFormResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data siteUploadFormResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := make(map[string]int)
+	for i, f := range data.Fields {
+		if f.Name.IsNull() || f.Name.IsUnknown() {
+			continue
+		}
+		name := f.Name.ValueString()
+		if first, ok := seen[name]; ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("field").AtListIndex(i).AtName("name"),
+				"Duplicate upload form field",
+				fmt.Sprintf("Field name %q is already used by field %d.", name, first),
+			)
+			continue
+		}
+		seen[name] = i
+	}
+}
+
+func (r *siteUploadFormResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if c, ok := req.ProviderData.(*client.Client); ok {
+		r.client = c
+	}
+}
+
+func (r *siteUploadFormResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var plan siteUploadFormResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	siteID, name := plan.SiteID.ValueString(), plan.Name.ValueString()
+	if err := r.client.CreateSiteUploadForm(ctx, siteID, name, plan.toAPIModel()); err != nil {
+		resp.Diagnostics.AddError("Failed to create upload form", err.Error())
+		return
+	}
+
+	form, err := r.client.GetSiteUploadForm(ctx, siteID, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read upload form after creation", err.Error())
+		return
+	}
+
+	plan.fromAPI(form)
+	plan.ID = types.StringValue(fmt.Sprintf("%s/%s", siteID, name))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *siteUploadFormResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var state siteUploadFormResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	forms, err := r.client.ListSiteUploadForms(ctx, state.SiteID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read upload forms", err.Error())
+		return
+	}
+
+	found := false
+	for _, f := range forms {
+		if f.ID == state.Name.ValueString() {
+			found = true
+			break
+		}
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// The list does not reliably carry the form elements, so the form is
+	// fetched individually.
+	form, err := r.client.GetSiteUploadForm(ctx, state.SiteID.ValueString(), state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read upload form", err.Error())
+		return
+	}
+
+	state.fromAPI(form)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *siteUploadFormResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var plan siteUploadFormResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	form, err := r.client.UpdateSiteUploadForm(ctx, plan.SiteID.ValueString(), plan.Name.ValueString(), plan.toAPIModel())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update upload form", err.Error())
+		return
+	}
+
+	plan.fromAPI(form)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *siteUploadFormResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var state siteUploadFormResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteSiteUploadForm(ctx, state.SiteID.ValueString(), state.Name.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Failed to delete upload form", err.Error())
+	}
+}
+
+func (r *siteUploadFormResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	siteID, name, ok := strings.Cut(req.ID, "/")
+	if !ok || siteID == "" || name == "" {
+		resp.Diagnostics.AddError("Invalid import identifier", "Expected identifier in the form <site_id>/<form_name>")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site_id"), siteID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
+
+func (m *siteUploadFormResourceModel) toAPIModel() client.UploadFormAttributes {
+	attrs := client.UploadFormAttributes{
+		DisplayName:             m.DisplayName.ValueString(),
+		Description:             m.Description.ValueString(),
+		DisplayInstruction:      m.Instructions.ValueString(),
+		Enabled:                 m.Enabled.ValueBool(),
+		ShowForEachFileUploaded: m.ShowForEachFile.ValueBool(),
+		UserGroupID:             stringValueOrEmpty(m.PermissionGroup),
+		Elements:                make([]client.UploadFormElement, 0, len(m.Fields)),
+	}
+
+	for _, f := range m.Fields {
+		label := f.Label.ValueString()
+		if f.Label.IsNull() {
+			label = f.Name.ValueString()
+		}
+		options := make([]string, 0, len(f.Options))
+		for _, o := range f.Options {
+			options = append(options, o.ValueString())
+		}
+		attrs.Elements = append(attrs.Elements, client.UploadFormElement{
+			Name:         f.Name.ValueString(),
+			DisplayLabel: label,
+			Type:         f.Type.ValueString(),
+			Values:       strings.Join(options, ","),
+			Required:     f.Required.ValueBool(),
+		})
+	}
+
+	return attrs
+}
+
+// fromAPI refreshes the model from EFT. Values EFT only re-cases, such as the
+// field type and the permission group GUID, keep their configured spelling,
+// and a label equal to the field name stays unset when it was not configured.
+func (m *siteUploadFormResourceModel) fromAPI(form *client.UploadForm) {
+	a := form.Attributes
+	m.DisplayName = types.StringValue(a.DisplayName)
+	m.Description = types.StringValue(a.Description)
+	m.Instructions = types.StringValue(a.DisplayInstruction)
+	m.Enabled = types.BoolValue(a.Enabled)
+	m.ShowForEachFile = types.BoolValue(a.ShowForEachFileUploaded)
+
+	switch {
+	case a.UserGroupID == "":
+		m.PermissionGroup = types.StringNull()
+	case !strings.EqualFold(m.PermissionGroup.ValueString(), a.UserGroupID):
+		m.PermissionGroup = types.StringValue(a.UserGroupID)
+	}
+
+	fields := make([]siteUploadFormFieldModel, 0, len(a.Elements))
+	for i, e := range a.Elements {
+		var prior siteUploadFormFieldModel
+		if i < len(m.Fields) {
+			prior = m.Fields[i]
+		}
+
+		f := siteUploadFormFieldModel{
+			Name:     types.StringValue(e.Name),
+			Label:    types.StringValue(e.DisplayLabel),
+			Type:     types.StringValue(e.Type),
+			Required: types.BoolValue(e.Required),
+		}
+		if prior.Label.IsNull() && e.DisplayLabel == e.Name {
+			f.Label = types.StringNull()
+		}
+		if strings.EqualFold(prior.Type.ValueString(), e.Type) {
+			f.Type = prior.Type
+		}
+		if e.Values != "" {
+			for _, o := range strings.Split(e.Values, ",") {
+				f.Options = append(f.Options, types.StringValue(strings.TrimSpace(o)))
+			}
+		}
+		fields = append(fields, f)
+	}
+	m.Fields = fields
+}
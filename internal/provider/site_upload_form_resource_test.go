@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSiteUploadFormToAPIModelPermissionGroup(t *testing.T) {
+	tests := []struct {
+		name  string
+		group types.String
+		want  string
+	}{
+		{name: "group set", group: types.StringValue("9f3c"), want: `"userGroupId":"9f3c"`},
+		// Removing the attribute must clear the group, so the key is sent.
+		{name: "group removed", group: types.StringNull(), want: `"userGroupId":""`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := siteUploadFormResourceModel{PermissionGroup: tt.group}
+			body, err := json.Marshal(m.toAPIModel())
+			if err != nil {
+				t.Fatalf("marshal: %v", err)
+			}
+			if !strings.Contains(string(body), tt.want) {
+				t.Errorf("toAPIModel() = %s, want it to contain %s", body, tt.want)
+			}
+		})
+	}
+}
+
+func TestSiteUploadFormToAPIModelFields(t *testing.T) {
+	m := siteUploadFormResourceModel{Fields: []siteUploadFormFieldModel{
+		{Name: types.StringValue("case_number"), Label: types.StringNull(), Type: types.StringValue("String"), Required: types.BoolValue(true)},
+		{
+			Name:    types.StringValue("department"),
+			Label:   types.StringValue("Department"),
+			Type:    types.StringValue("String"),
+			Options: []types.String{types.StringValue("Legal"), types.StringValue("HR")},
+		},
+	}}
+
+	got := m.toAPIModel().Elements
+	want := []client.UploadFormElement{
+		{Name: "case_number", DisplayLabel: "case_number", Type: "String", Required: true},
+		{Name: "department", DisplayLabel: "Department", Type: "String", Values: "Legal,HR"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("elements = %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("element %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestSiteUploadFormFromAPI(t *testing.T) {
+	form := &client.UploadForm{ID: "case-intake", Attributes: client.UploadFormAttributes{
+		DisplayName: "Case intake",
+		UserGroupID: "9F3C",
+		Elements: []client.UploadFormElement{
+			{Name: "case_number", DisplayLabel: "case_number", Type: "STRING", Required: true},
+			{Name: "department", DisplayLabel: "Dept", Type: "String", Values: "Legal, HR"},
+		},
+	}}
+
+	tests := []struct {
+		name      string
+		prior     siteUploadFormResourceModel
+		wantGroup types.String
+		wantLabel types.String
+		wantType  string
+	}{
+		{
+			name: "configured spelling kept",
+			prior: siteUploadFormResourceModel{
+				PermissionGroup: types.StringValue("9f3c"),
+				Fields:          []siteUploadFormFieldModel{{Label: types.StringNull(), Type: types.StringValue("String")}},
+			},
+			wantGroup: types.StringValue("9f3c"),
+			wantLabel: types.StringNull(),
+			wantType:  "String",
+		},
+		{
+			name:      "import reads EFT values",
+			prior:     siteUploadFormResourceModel{PermissionGroup: types.StringNull()},
+			wantGroup: types.StringValue("9F3C"),
+			wantLabel: types.StringNull(),
+			wantType:  "STRING",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := tt.prior
+			m.fromAPI(form)
+
+			if !m.PermissionGroup.Equal(tt.wantGroup) {
+				t.Errorf("permission_group_id = %s, want %s", m.PermissionGroup, tt.wantGroup)
+			}
+			if len(m.Fields) != 2 {
+				t.Fatalf("fields = %d, want 2", len(m.Fields))
+			}
+			if !m.Fields[0].Label.Equal(tt.wantLabel) || m.Fields[0].Type.ValueString() != tt.wantType {
+				t.Errorf("field 0 = %+v, want label %s type %s", m.Fields[0], tt.wantLabel, tt.wantType)
+			}
+			if opts := m.Fields[1].Options; len(opts) != 2 || opts[1].ValueString() != "HR" {
+				t.Errorf("options = %v, want [Legal HR]", opts)
+			}
+		})
+	}
+
+	cleared := siteUploadFormResourceModel{PermissionGroup: types.StringValue("9f3c")}
+	cleared.fromAPI(&client.UploadForm{})
+	if !cleared.PermissionGroup.IsNull() {
+		t.Errorf("permission_group_id = %s, want null when EFT has no group", cleared.PermissionGroup)
+	}
+}
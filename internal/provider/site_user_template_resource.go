@@ -0,0 +1,932 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &siteUserTemplateResource{}
+var _ resource.ResourceWithConfigure = &siteUserTemplateResource{}
+var _ resource.ResourceWithImportState = &siteUserTemplateResource{}
+var _ resource.ResourceWithValidateConfig = &siteUserTemplateResource{}
+
+func NewSiteUserTemplateResource() resource.Resource {
+	return &siteUserTemplateResource{}
+}
+
+type siteUserTemplateResource struct {
+	client *client.Client
+}
+
+type siteUserTemplateResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	SiteID             types.String `tfsdk:"site_id"`
+	TemplateID         types.String `tfsdk:"template_id"`
+	Name               types.String `tfsdk:"name"`
+	Enabled            types.Bool   `tfsdk:"enabled"`
+	SettingsJSON       types.String `tfsdk:"settings_json"`
+	AS2                types.Object `tfsdk:"as2"`
+	ChangePassword     types.Object `tfsdk:"change_password"`
+	ConnectionLimits   types.Object `tfsdk:"connection_limits"`
+	FTP                types.Object `tfsdk:"ftp"`
+	FTPS               types.Object `tfsdk:"ftps"`
+	HTTP               types.Object `tfsdk:"http"`
+	InvalidLoginLimit  types.Object `tfsdk:"invalid_login_limit"`
+	IPAccessLimit      types.Object `tfsdk:"ip_access_limit"`
+	PasswordComplexity types.Object `tfsdk:"password_complexity"`
+	HomeFolder         types.Object `tfsdk:"home_folder"`
+}
+
+func (m *siteUserTemplateResourceModel) blocks() map[string]*types.Object {
+	return map[string]*types.Object{
+		"as2":                 &m.AS2,
+		"change_password":     &m.ChangePassword,
+		"connection_limits":   &m.ConnectionLimits,
+		"ftp":                 &m.FTP,
+		"ftps":                &m.FTPS,
+		"http":                &m.HTTP,
+		"invalid_login_limit": &m.InvalidLoginLimit,
+		"ip_access_limit":     &m.IPAccessLimit,
+		"password_complexity": &m.PasswordComplexity,
+		"home_folder":         &m.HomeFolder,
+	}
+}
+
+type userTemplateFieldKind int
+
+const (
+	userTemplateBool userTemplateFieldKind = iota
+	// userTemplateInherit is `yes`, `no` or `inherit`, sent as a boolean or
+	// the string "inherit".
+	userTemplateInherit
+	// userTemplateYesNo is `yes`, `no` or `inherit`, sent as is.
+	userTemplateYesNo
+	userTemplateInt
+	userTemplateString
+	// userTemplateLimit is a number stored in an {enabled, value} setting,
+	// where 0 disables the setting.
+	userTemplateLimit
+)
+
+// userTemplateField maps a block attribute onto its location in the template
+// attributes.
+type userTemplateField struct {
+	name        string
+	description string
+	kind        userTemplateFieldKind
+	path        []string
+	valueKey    string
+}
+
+type userTemplateBlock struct {
+	name        string
+	description string
+	fields      []userTemplateField
+}
+
+var userTemplateBlocks = []userTemplateBlock{
+	{
+		name:        "as2",
+		description: "AS2 transfers.",
+		fields: []userTemplateField{
+			{name: "inbound_enabled", description: "Allow inbound AS2 transfers.", kind: userTemplateBool, path: []string{"as2", "inbound", "enabled"}},
+			{name: "outbound_enabled", description: "Allow outbound AS2 transfers.", kind: userTemplateBool, path: []string{"as2", "outbound", "enabled"}},
+		},
+	},
+	{
+		name:        "change_password",
+		description: "Password change and expiration policy.",
+		fields: []userTemplateField{
+			{name: "enabled", description: "Allow users to change their password (`yes`, `no`, or `inherit`).", kind: userTemplateInherit, path: []string{"changePassword", "enabled"}},
+			{name: "change_admin_provided_password", description: "Require a password set by an administrator to be changed on first use (`yes`, `no`, or `inherit`).", kind: userTemplateInherit, path: []string{"changePassword", "value", "changeAdminProvidedPasswordUponFirstUse"}},
+			{name: "expiration_enabled", description: "Expire passwords after `max_age_days`.", kind: userTemplateBool, path: []string{"changePassword", "value", "passwordExpiration", "enabled"}},
+			{name: "max_age_days", description: "Days before a password expires.", kind: userTemplateInt, path: []string{"changePassword", "value", "passwordExpiration", "value", "maxAgeDays"}},
+			{name: "email_on_expiration", description: "Email the user when the password expires.", kind: userTemplateBool, path: []string{"changePassword", "value", "passwordExpiration", "value", "emailUpon"}},
+			{name: "reminder_enabled", description: "Remind the user before the password expires.", kind: userTemplateBool, path: []string{"changePassword", "value", "passwordExpiration", "value", "remindPrior", "enabled"}},
+			{name: "reminder_days_before", description: "Days before expiration the reminder is sent.", kind: userTemplateInt, path: []string{"changePassword", "value", "passwordExpiration", "value", "remindPrior", "value", "daysBefore"}},
+			{name: "reminder_email_user", description: "Send the reminder by email.", kind: userTemplateBool, path: []string{"changePassword", "value", "passwordExpiration", "value", "remindPrior", "value", "emailUser"}},
+		},
+	},
+	{
+		name:        "connection_limits",
+		description: "Connection, transfer and timeout limits. `0` disables a limit.",
+		fields: []userTemplateField{
+			{name: "timeout_seconds", description: "Disconnect idle sessions after this many seconds.", kind: userTemplateLimit, path: []string{"connectionTimeout"}, valueKey: "maxSec"},
+			{name: "max_connections_per_ip", description: "Maximum concurrent connections from the same IP address.", kind: userTemplateLimit, path: []string{"connectionsFromSameIpLimit"}, valueKey: "maxNumber"},
+			{name: "max_total_connections", description: "Maximum concurrent connections.", kind: userTemplateLimit, path: []string{"totalConnectionsLimit"}, valueKey: "maxNumber"},
+			{name: "max_downloads_per_session", description: "Maximum downloads per session.", kind: userTemplateLimit, path: []string{"downloadsPerSessionLimit"}, valueKey: "maxNumber"},
+			{name: "max_download_size_kb", description: "Maximum size of a download, in kilobytes.", kind: userTemplateLimit, path: []string{"downloadSizeLimit"}, valueKey: "maxKBytes"},
+			{name: "max_transfer_speed_kbps", description: "Maximum transfer speed, in kilobits per second.", kind: userTemplateLimit, path: []string{"transferSpeedLimit"}, valueKey: "maxKbps"},
+		},
+	},
+	{
+		name:        "ftp",
+		description: "FTP protocol settings.",
+		fields: []userTemplateField{
+			{name: "enabled", description: "Allow FTP connections.", kind: userTemplateBool, path: []string{"ftp", "enabled"}},
+			{name: "enable_fxp", description: "Allow site-to-site (FXP) transfers (`yes`, `no`, or `inherit`).", kind: userTemplateInherit, path: []string{"ftp", "value", "enableFxp"}},
+			{name: "enable_noop", description: "Allow the `NOOP` keep-alive command (`yes`, `no`, or `inherit`).", kind: userTemplateInherit, path: []string{"ftp", "value", "enableNoop"}},
+			{name: "enable_comb", description: "Allow the `COMB` command (`yes`, `no`, or `inherit`).", kind: userTemplateYesNo, path: []string{"ftp", "value", "enableComb"}},
+			{name: "enable_xcrc", description: "Allow the `XCRC` command (`yes`, `no`, or `inherit`).", kind: userTemplateYesNo, path: []string{"ftp", "value", "enableXcrc"}},
+			{name: "enable_zlib", description: "Allow `MODE Z` compression.", kind: userTemplateBool, path: []string{"ftp", "value", "enableZlib"}},
+			{name: "banner_message", description: "Message shown to users after login.", kind: userTemplateString, path: []string{"ftp", "value", "banner", "message"}},
+			{name: "banner_usage", description: "How `banner_message` is combined with the site banner, e.g. `replaceDefault`.", kind: userTemplateString, path: []string{"ftp", "value", "banner", "usage"}},
+		},
+	},
+	{
+		name:        "ftps",
+		description: "FTPS protocol settings.",
+		fields: []userTemplateField{
+			{name: "enabled", description: "Allow FTPS connections.", kind: userTemplateBool, path: []string{"ftps", "enabled"}},
+		},
+	},
+	{
+		name:        "http",
+		description: "HTTP, HTTPS and Web Transfer Client settings.",
+		fields: []userTemplateField{
+			{name: "enable_http", description: "Allow HTTP connections.", kind: userTemplateBool, path: []string{"http", "enableHttp"}},
+			{name: "enable_https", description: "Allow HTTPS connections.", kind: userTemplateBool, path: []string{"http", "enableHttps"}},
+			{name: "enable_wtc", description: "Allow the Web Transfer Client.", kind: userTemplateBool, path: []string{"http", "enableWtc"}},
+			{name: "enable_share_folder", description: "Allow users to share folders.", kind: userTemplateBool, path: []string{"http", "enableShareFolder"}},
+			{name: "enable_send_message", description: "Allow users to send files with the Send portal.", kind: userTemplateBool, path: []string{"http", "enableSendMessage"}},
+		},
+	},
+	{
+		name:        "invalid_login_limit",
+		description: "Lockout after repeated invalid logins.",
+		fields: []userTemplateField{
+			{name: "enabled", description: "Enforce the limit (`yes`, `no`, or `inherit`).", kind: userTemplateInherit, path: []string{"invalidLoginLimit", "enabled"}},
+			{name: "max_count", description: "Invalid logins allowed within `period_minutes`.", kind: userTemplateInt, path: []string{"invalidLoginLimit", "value", "maxCount"}},
+			{name: "period_minutes", description: "Window, in minutes, in which invalid logins are counted.", kind: userTemplateInt, path: []string{"invalidLoginLimit", "value", "periodMin"}},
+			{name: "action", description: "Action taken once the limit is reached, e.g. `lock`.", kind: userTemplateString, path: []string{"invalidLoginLimit", "value", "action"}},
+			{name: "action_duration_minutes", description: "Minutes the action lasts.", kind: userTemplateInt, path: []string{"invalidLoginLimit", "value", "actionDurationMin"}},
+		},
+	},
+	{
+		name:        "ip_access_limit",
+		description: "Per-user IP access restrictions. The rules themselves are set with `settings_json`.",
+		fields: []userTemplateField{
+			{name: "enabled", description: "Apply the IP access rules.", kind: userTemplateBool, path: []string{"ipAccessLimit", "enabled"}},
+			{name: "default_rule", description: "Rule applied to addresses matching no rule, e.g. `allowAccess`.", kind: userTemplateString, path: []string{"ipAccessLimit", "value", "defaultRule"}},
+		},
+	},
+	{
+		name:        "password_complexity",
+		description: "Password complexity requirements. `0` disables the username and repeating character limits.",
+		fields: []userTemplateField{
+			{name: "enabled", description: "Enforce the requirements (`yes`, `no`, or `inherit`).", kind: userTemplateInherit, path: []string{"passwordComplexity", "enabled"}},
+			{name: "min_length", description: "Minimum password length.", kind: userTemplateInt, path: []string{"passwordComplexity", "value", "minLength"}},
+			{name: "character_categories_enabled", description: "Require characters from several categories.", kind: userTemplateBool, path: []string{"passwordComplexity", "value", "characterCategories", "enabled"}},
+			{name: "character_categories_count", description: "Number of categories a password must use.", kind: userTemplateInt, path: []string{"passwordComplexity", "value", "characterCategories", "value", "characterCount"}},
+			{name: "require_upper_case", description: "Require an upper-case letter.", kind: userTemplateBool, path: []string{"passwordComplexity", "value", "characterCategories", "value", "upperCaseRequired"}},
+			{name: "require_lower_case", description: "Require a lower-case letter.", kind: userTemplateBool, path: []string{"passwordComplexity", "value", "characterCategories", "value", "lowerCaseRequired"}},
+			{name: "require_numeric", description: "Require a digit.", kind: userTemplateBool, path: []string{"passwordComplexity", "value", "characterCategories", "value", "numericRequired"}},
+			{name: "require_non_alphanumeric", description: "Require a non-alphanumeric character.", kind: userTemplateBool, path: []string{"passwordComplexity", "value", "characterCategories", "value", "nonAlphaNumericRequired"}},
+			{name: "require_non_7bit_ascii", description: "Require a character outside 7-bit ASCII.", kind: userTemplateBool, path: []string{"passwordComplexity", "value", "characterCategories", "value", "non7bitAsciiRequired"}},
+			{name: "username_chars_disallowed", description: "Reject passwords containing this many consecutive characters of the username.", kind: userTemplateLimit, path: []string{"passwordComplexity", "value", "usernameCharLimit"}, valueKey: "minDisallowed"},
+			{name: "repeating_chars_disallowed", description: "Reject passwords repeating a character this many times.", kind: userTemplateLimit, path: []string{"passwordComplexity", "value", "repeatingCharLimit"}, valueKey: "minDisallowed"},
+			{name: "dictionary_enabled", description: "Reject passwords found in `dictionary_file_path`.", kind: userTemplateBool, path: []string{"passwordComplexity", "value", "forbiddenDictionary", "enabled"}},
+			{name: "dictionary_file_path", description: "Path, on the EFT server, of the forbidden words file.", kind: userTemplateString, path: []string{"passwordComplexity", "value", "forbiddenDictionary", "value", "dictionaryFilePath"}},
+			{name: "dictionary_no_backwards_word", description: "Also reject forbidden words spelled backwards.", kind: userTemplateBool, path: []string{"passwordComplexity", "value", "forbiddenDictionary", "value", "noBackwardsWord"}},
+		},
+	},
+	{
+		name:        "home_folder",
+		description: "Home folder assigned to users.",
+		fields: []userTemplateField{
+			{name: "enabled", description: "Assign a home folder.", kind: userTemplateBool, path: []string{"homeFolder", "enabled"}},
+			{name: "path", description: "Virtual path of the home folder.", kind: userTemplateString, path: []string{"homeFolder", "value", "path"}},
+			{name: "as_root", description: "Show the home folder as the root folder.", kind: userTemplateBool, path: []string{"hasHomeFolderAsRoot"}},
+		},
+	},
+}
+
+func (r *siteUserTemplateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_site_user_template"
+}
+
+func (r *siteUserTemplateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	blocks := make(map[string]schema.Block, len(userTemplateBlocks))
+	for _, b := range userTemplateBlocks {
+		attributes := make(map[string]schema.Attribute, len(b.fields))
+		for _, f := range b.fields {
+			attributes[f.name] = f.schemaAttribute()
+		}
+		blocks[b.name] = schema.SingleNestedBlock{
+			MarkdownDescription: b.description + " Only managed when present; unset attributes are not managed.",
+			Attributes:          attributes,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a user settings template of a Globalscape EFT site. Requires EFT 8.1.0 or later. Only the settings present in the configuration are managed.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier in the form `<site_id>/<template_id>`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site_id": schema.StringAttribute{
+				MarkdownDescription: "Site identifier that owns the template.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"template_id": schema.StringAttribute{
+				MarkdownDescription: "Template GUID assigned by EFT.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Template name. Use `Default Settings` to manage the site's default template.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether accounts created from the template are enabled. Not managed when unset.",
+				Optional:            true,
+			},
+			"settings_json": schema.StringAttribute{
+				MarkdownDescription: "JSON object of additional template attributes, as documented by EFT, for settings without a block. Only the keys present are managed. Settings set by `name`, `enabled` or a block cannot also be given here.",
+				Optional:            true,
+			},
+		},
+		Blocks: blocks,
+	}
+}
+
+func (r *siteUserTemplateResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if c, ok := req.ProviderData.(*client.Client); ok {
+		r.client = c
+	}
+}
+
+// ValidateConfig rejects settings_json keys that are also set by name,
+// enabled or a block, so each setting has a single source.
+func (r *siteUserTemplateResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config siteUserTemplateResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.SettingsJSON.IsNull() || config.SettingsJSON.IsUnknown() {
+		return
+	}
+
+	settings, err := parseUserTemplateSettings(config.SettingsJSON.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("settings_json"), "Invalid settings_json", err.Error())
+		return
+	}
+
+	for _, overlap := range config.settingsOverlaps(settings) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("settings_json"),
+			"Conflicting settings_json key",
+			fmt.Sprintf("settings_json sets %q, which is also managed by %s. Remove it from one of them.", strings.Join(overlap.path, "."), overlap.attribute),
+		)
+	}
+}
+
+func (r *siteUserTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var plan siteUserTemplateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.RequireServerVersion(ctx, client.UserTemplatesMinVersion); err != nil {
+		resp.Diagnostics.AddError("User templates are not supported", err.Error())
+		return
+	}
+
+	desired, diags := plan.toAPIModel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	siteID := plan.SiteID.ValueString()
+	templateID := ""
+
+	// The default template always exists and cannot be created, so it is
+	// adopted and updated instead.
+	if plan.Name.ValueString() == client.DefaultUserTemplateName {
+		id, err := r.findTemplateByName(ctx, siteID, client.DefaultUserTemplateName)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to read user templates", err.Error())
+			return
+		}
+		if id == "" {
+			resp.Diagnostics.AddError("Default user template not found", fmt.Sprintf("Site %s has no %q template.", siteID, client.DefaultUserTemplateName))
+			return
+		}
+		if err := r.update(ctx, siteID, id, desired); err != nil {
+			resp.Diagnostics.AddError("Failed to update user template", err.Error())
+			return
+		}
+		templateID = id
+	} else {
+		body, err := json.Marshal(desired)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to encode user template", err.Error())
+			return
+		}
+		template, err := r.client.CreateSiteUserTemplate(ctx, siteID, body)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to create user template", err.Error())
+			return
+		}
+		templateID = template.ID
+
+		// Fall back to the name when the response does not carry the new ID.
+		if templateID == "" {
+			templateID, err = r.findTemplateByName(ctx, siteID, plan.Name.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddError("Failed to read user templates", err.Error())
+				return
+			}
+			if templateID == "" {
+				resp.Diagnostics.AddError("User template not found", fmt.Sprintf("EFT did not report the ID of user template %q after creating it.", plan.Name.ValueString()))
+				return
+			}
+		}
+	}
+
+	template, err := r.client.GetSiteUserTemplate(ctx, siteID, templateID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read user template", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(plan.fromAPI(template)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *siteUserTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var state siteUserTemplateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	templates, err := r.client.ListSiteUserTemplates(ctx, state.SiteID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read user templates", err.Error())
+		return
+	}
+
+	found := false
+	for _, t := range templates {
+		if strings.EqualFold(t.ID, state.TemplateID.ValueString()) {
+			found = true
+			break
+		}
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	template, err := r.client.GetSiteUserTemplate(ctx, state.SiteID.ValueString(), state.TemplateID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read user template", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(state.fromAPI(template)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *siteUserTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var plan siteUserTemplateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired, diags := plan.toAPIModel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	siteID, templateID := plan.SiteID.ValueString(), plan.TemplateID.ValueString()
+	if err := r.update(ctx, siteID, templateID, desired); err != nil {
+		resp.Diagnostics.AddError("Failed to update user template", err.Error())
+		return
+	}
+
+	template, err := r.client.GetSiteUserTemplate(ctx, siteID, templateID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read user template", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(plan.fromAPI(template)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *siteUserTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var state siteUserTemplateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.Name.ValueString() == client.DefaultUserTemplateName {
+		// The default template cannot be deleted. Removing the resource from
+		// Terraform state only; the last applied settings remain on the site.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err := r.client.DeleteSiteUserTemplate(ctx, state.SiteID.ValueString(), state.TemplateID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Failed to delete user template", err.Error())
+	}
+}
+
+// ImportState accepts either the template GUID or its name after the site ID.
+func (r *siteUserTemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	siteID, key, ok := strings.Cut(req.ID, "/")
+	if !ok || siteID == "" || key == "" {
+		resp.Diagnostics.AddError("Invalid import identifier", "Expected identifier in the form <site_id>/<template_id> or <site_id>/<template_name>")
+		return
+	}
+
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	templates, err := r.client.ListSiteUserTemplates(ctx, siteID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read user templates", err.Error())
+		return
+	}
+
+	templateID := ""
+	for _, t := range templates {
+		if strings.EqualFold(t.ID, key) {
+			templateID = t.ID
+			break
+		}
+	}
+	if templateID == "" {
+		for _, t := range templates {
+			if t.Name() != key {
+				continue
+			}
+			if templateID != "" {
+				resp.Diagnostics.AddError("Ambiguous user template name", fmt.Sprintf("More than one user template on site %s is named %q; import it by ID instead.", siteID, key))
+				return
+			}
+			templateID = t.ID
+		}
+	}
+	if templateID == "" {
+		resp.Diagnostics.AddError("User template not found", fmt.Sprintf("No user template with ID or name %q exists on site %s.", key, siteID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fmt.Sprintf("%s/%s", siteID, templateID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site_id"), siteID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("template_id"), templateID)...)
+}
+
+func (r *siteUserTemplateResource) findTemplateByName(ctx context.Context, siteID, name string) (string, error) {
+	templates, err := r.client.ListSiteUserTemplates(ctx, siteID)
+	if err != nil {
+		return "", err
+	}
+	for _, t := range templates {
+		if t.Name() == name {
+			return t.ID, nil
+		}
+	}
+	return "", nil
+}
+
+// update sends each top-level setting in desired merged over its current
+// value, so nested settings that are not managed keep their values.
+func (r *siteUserTemplateResource) update(ctx context.Context, siteID, templateID string, desired map[string]any) error {
+	current, err := r.client.GetSiteUserTemplate(ctx, siteID, templateID)
+	if err != nil {
+		return err
+	}
+
+	currentAttrs, err := parseUserTemplateSettings(string(current.Attributes))
+	if err != nil {
+		return fmt.Errorf("decode user template: %w", err)
+	}
+
+	attrs := make(map[string]any, len(desired))
+	for key, value := range desired {
+		attrs[key] = mergeJSONValues(currentAttrs[key], value)
+	}
+
+	body, err := json.Marshal(attrs)
+	if err != nil {
+		return err
+	}
+	_, err = r.client.UpdateSiteUserTemplate(ctx, siteID, templateID, body)
+	return err
+}
+
+type userTemplateOverlap struct {
+	attribute string
+	path      []string
+}
+
+// settingsOverlaps lists the settings that settings is given together with
+// name, enabled or a block attribute.
+func (m *siteUserTemplateResourceModel) settingsOverlaps(settings map[string]any) []userTemplateOverlap {
+	var overlaps []userTemplateOverlap
+	if _, ok := settings["name"]; ok {
+		overlaps = append(overlaps, userTemplateOverlap{attribute: "name", path: []string{"name"}})
+	}
+	if _, ok := settings["enabled"]; ok && !m.Enabled.IsNull() {
+		overlaps = append(overlaps, userTemplateOverlap{attribute: "enabled", path: []string{"enabled"}})
+	}
+
+	blocks := m.blocks()
+	for _, b := range userTemplateBlocks {
+		block := *blocks[b.name]
+		if block.IsNull() || block.IsUnknown() {
+			continue
+		}
+		values := block.Attributes()
+		for _, f := range b.fields {
+			if value := values[f.name]; value == nil || value.IsNull() {
+				continue
+			}
+			if keys, ok := overlappingJSONPath(settings, f.path); ok {
+				overlaps = append(overlaps, userTemplateOverlap{attribute: b.name + "." + f.name, path: keys})
+			}
+		}
+	}
+	return overlaps
+}
+
+// toAPIModel lays the blocks over settings_json. Only values set in the
+// configuration are included.
+func (m *siteUserTemplateResourceModel) toAPIModel() (map[string]any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attrs := map[string]any{}
+	if !m.SettingsJSON.IsNull() && !m.SettingsJSON.IsUnknown() {
+		settings, err := parseUserTemplateSettings(m.SettingsJSON.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("settings_json"), "Invalid settings_json", err.Error())
+			return nil, diags
+		}
+		attrs = settings
+	}
+
+	blocks := m.blocks()
+	for _, b := range userTemplateBlocks {
+		block := *blocks[b.name]
+		if block.IsNull() || block.IsUnknown() {
+			continue
+		}
+		values := block.Attributes()
+		for _, f := range b.fields {
+			if value, ok := f.toAPI(values[f.name]); ok {
+				setJSONPath(attrs, f.path, value)
+			}
+		}
+	}
+
+	attrs["name"] = m.Name.ValueString()
+	if !m.Enabled.IsNull() && !m.Enabled.IsUnknown() {
+		attrs["enabled"] = m.Enabled.ValueBool()
+	}
+	return attrs, diags
+}
+
+// fromAPI refreshes the managed values only: blocks and block attributes
+// that are unset stay unset, and settings_json keeps the keys it already had.
+func (m *siteUserTemplateResourceModel) fromAPI(template *client.UserTemplate) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	attrs, err := parseUserTemplateSettings(string(template.Attributes))
+	if err != nil {
+		diags.AddError("Failed to decode user template", err.Error())
+		return diags
+	}
+
+	m.TemplateID = types.StringValue(template.ID)
+	m.ID = types.StringValue(fmt.Sprintf("%s/%s", m.SiteID.ValueString(), template.ID))
+	m.Name = types.StringValue(template.Name())
+	if !m.Enabled.IsNull() {
+		enabled, ok := attrs["enabled"].(bool)
+		m.Enabled = types.BoolNull()
+		if ok {
+			m.Enabled = types.BoolValue(enabled)
+		}
+	}
+
+	blocks := m.blocks()
+	for _, b := range userTemplateBlocks {
+		block := blocks[b.name]
+		if block.IsNull() || block.IsUnknown() {
+			continue
+		}
+		prior := block.Attributes()
+		values := make(map[string]attr.Value, len(b.fields))
+		for _, f := range b.fields {
+			value := prior[f.name]
+			if value != nil && !value.IsNull() {
+				value = f.fromAPI(attrs)
+			}
+			values[f.name] = value
+		}
+		refreshed, d := types.ObjectValue(b.attrTypes(), values)
+		diags.Append(d...)
+		*block = refreshed
+	}
+
+	if !m.SettingsJSON.IsNull() {
+		settings, err := parseUserTemplateSettings(m.SettingsJSON.ValueString())
+		if err != nil {
+			diags.AddError("Failed to decode settings_json", err.Error())
+			return diags
+		}
+		projected, err := json.Marshal(projectJSON(settings, attrs))
+		if err != nil {
+			diags.AddError("Failed to encode settings_json", err.Error())
+			return diags
+		}
+		prior, err := normalizeRawJSON(json.RawMessage(m.SettingsJSON.ValueString()))
+		if err != nil || prior != string(projected) {
+			m.SettingsJSON = types.StringValue(string(projected))
+		}
+	}
+	return diags
+}
+
+func (f userTemplateField) schemaAttribute() schema.Attribute {
+	switch f.kind {
+	case userTemplateBool:
+		return schema.BoolAttribute{MarkdownDescription: f.description, Optional: true}
+	case userTemplateInherit, userTemplateYesNo:
+		return schema.StringAttribute{
+			MarkdownDescription: f.description,
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(yesNoInherit...),
+			},
+		}
+	case userTemplateInt, userTemplateLimit:
+		return schema.Int64Attribute{
+			MarkdownDescription: f.description,
+			Optional:            true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+			},
+		}
+	default:
+		return schema.StringAttribute{MarkdownDescription: f.description, Optional: true}
+	}
+}
+
+func (f userTemplateField) attrType() attr.Type {
+	switch f.kind {
+	case userTemplateBool:
+		return types.BoolType
+	case userTemplateInt, userTemplateLimit:
+		return types.Int64Type
+	default:
+		return types.StringType
+	}
+}
+
+func (b userTemplateBlock) attrTypes() map[string]attr.Type {
+	attrTypes := make(map[string]attr.Type, len(b.fields))
+	for _, f := range b.fields {
+		attrTypes[f.name] = f.attrType()
+	}
+	return attrTypes
+}
+
+func (f userTemplateField) toAPI(value attr.Value) (any, bool) {
+	if value == nil || value.IsNull() || value.IsUnknown() {
+		return nil, false
+	}
+
+	switch f.kind {
+	case userTemplateBool:
+		return value.(types.Bool).ValueBool(), true
+	case userTemplateInherit:
+		switch v := value.(types.String).ValueString(); v {
+		case "yes":
+			return true, true
+		case "no":
+			return false, true
+		default:
+			return v, true
+		}
+	case userTemplateInt:
+		return value.(types.Int64).ValueInt64(), true
+	case userTemplateLimit:
+		n := value.(types.Int64).ValueInt64()
+		if n == 0 {
+			return map[string]any{"enabled": false}, true
+		}
+		return map[string]any{"enabled": true, "value": map[string]any{f.valueKey: n}}, true
+	default:
+		return value.(types.String).ValueString(), true
+	}
+}
+
+// fromAPI reads the field from the template attributes. Values of an
+// unexpected type are reported as null.
+func (f userTemplateField) fromAPI(attrs map[string]any) attr.Value {
+	raw, _ := getJSONPath(attrs, f.path)
+
+	switch f.kind {
+	case userTemplateBool:
+		if v, ok := raw.(bool); ok {
+			return types.BoolValue(v)
+		}
+		return types.BoolNull()
+	case userTemplateInherit, userTemplateYesNo:
+		switch v := raw.(type) {
+		case bool:
+			if v {
+				return types.StringValue("yes")
+			}
+			return types.StringValue("no")
+		case string:
+			return types.StringValue(v)
+		}
+		return types.StringNull()
+	case userTemplateInt:
+		if v, ok := raw.(float64); ok {
+			return types.Int64Value(int64(v))
+		}
+		return types.Int64Null()
+	case userTemplateLimit:
+		setting, ok := raw.(map[string]any)
+		if !ok {
+			return types.Int64Null()
+		}
+		if enabled, _ := setting["enabled"].(bool); !enabled {
+			return types.Int64Value(0)
+		}
+		value, _ := setting["value"].(map[string]any)
+		if v, ok := value[f.valueKey].(float64); ok {
+			return types.Int64Value(int64(v))
+		}
+		return types.Int64Null()
+	default:
+		if v, ok := raw.(string); ok {
+			return types.StringValue(v)
+		}
+		return types.StringNull()
+	}
+}
+
+func parseUserTemplateSettings(value string) (map[string]any, error) {
+	var settings map[string]any
+	if err := json.Unmarshal([]byte(value), &settings); err != nil {
+		return nil, err
+	}
+	if settings == nil {
+		return nil, fmt.Errorf("expected a JSON object")
+	}
+	return settings, nil
+}
+
+func getJSONPath(root map[string]any, keys []string) (any, bool) {
+	var current any = root
+	for _, key := range keys {
+		object, ok := current.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if current, ok = object[key]; !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// overlappingJSONPath reports whether root holds a value at keys, or a
+// non-object value on the way to it, and returns the keys leading to it.
+func overlappingJSONPath(root map[string]any, keys []string) ([]string, bool) {
+	object := root
+	for i, key := range keys {
+		value, ok := object[key]
+		if !ok {
+			return nil, false
+		}
+		if i == len(keys)-1 {
+			return keys, true
+		}
+		if object, ok = value.(map[string]any); !ok {
+			return keys[:i+1], true
+		}
+	}
+	return nil, false
+}
+
+func setJSONPath(root map[string]any, keys []string, value any) {
+	object := root
+	for _, key := range keys[:len(keys)-1] {
+		next, ok := object[key].(map[string]any)
+		if !ok {
+			next = map[string]any{}
+			object[key] = next
+		}
+		object = next
+	}
+	last := keys[len(keys)-1]
+	object[last] = mergeJSONValues(object[last], value)
+}
+
+// mergeJSONValues returns overlay merged over base. Objects are merged key by
+// key; any other value replaces base.
+func mergeJSONValues(base, overlay any) any {
+	baseObject, ok := base.(map[string]any)
+	if !ok {
+		return overlay
+	}
+	overlayObject, ok := overlay.(map[string]any)
+	if !ok {
+		return overlay
+	}
+
+	merged := make(map[string]any, len(baseObject)+len(overlayObject))
+	for key, value := range baseObject {
+		merged[key] = value
+	}
+	for key, value := range overlayObject {
+		merged[key] = mergeJSONValues(baseObject[key], value)
+	}
+	return merged
+}
+
+// projectJSON keeps only the keys of actual that are present in shape.
+// Arrays and scalars are taken whole.
+func projectJSON(shape, actual any) any {
+	shapeObject, ok := shape.(map[string]any)
+	if !ok {
+		return actual
+	}
+	actualObject, ok := actual.(map[string]any)
+	if !ok {
+		return actual
+	}
+
+	projected := make(map[string]any, len(shapeObject))
+	for key, value := range shapeObject {
+		if actualValue, ok := actualObject[key]; ok {
+			projected[key] = projectJSON(value, actualValue)
+		}
+	}
+	return projected
+}
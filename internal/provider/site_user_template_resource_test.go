@@ -0,0 +1,289 @@
+package provider
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func decodeTestJSON(t *testing.T, value string) any {
+	t.Helper()
+	var decoded any
+	if err := json.Unmarshal([]byte(value), &decoded); err != nil {
+		t.Fatalf("decode %s: %v", value, err)
+	}
+	return decoded
+}
+
+func TestMergeJSONValues(t *testing.T) {
+	tests := []struct {
+		name    string
+		base    string
+		overlay string
+		want    string
+	}{
+		{name: "scalar replaces scalar", base: `1`, overlay: `2`, want: `2`},
+		{name: "object replaces scalar", base: `true`, overlay: `{"a":1}`, want: `{"a":1}`},
+		{name: "scalar replaces object", base: `{"a":1}`, overlay: `false`, want: `false`},
+		{name: "array replaced whole", base: `[1,2]`, overlay: `[3]`, want: `[3]`},
+		{name: "base missing", base: `null`, overlay: `{"a":1}`, want: `{"a":1}`},
+		{name: "keys merged", base: `{"a":1,"b":2}`, overlay: `{"b":3,"c":4}`, want: `{"a":1,"b":3,"c":4}`},
+		{
+			name:    "nested keys merged",
+			base:    `{"enabled":true,"value":{"maxAgeDays":90,"emailUpon":true}}`,
+			overlay: `{"value":{"maxAgeDays":30}}`,
+			want:    `{"enabled":true,"value":{"maxAgeDays":30,"emailUpon":true}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mergeJSONValues(decodeTestJSON(t, tt.base), decodeTestJSON(t, tt.overlay))
+			if want := decodeTestJSON(t, tt.want); !reflect.DeepEqual(got, want) {
+				t.Errorf("mergeJSONValues() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestMergeJSONValuesLeavesBaseUnchanged(t *testing.T) {
+	base := map[string]any{"a": map[string]any{"b": 1.0}}
+	mergeJSONValues(base, map[string]any{"a": map[string]any{"b": 2.0}})
+
+	if got := base["a"].(map[string]any)["b"]; got != 1.0 {
+		t.Errorf("base modified, a.b = %v", got)
+	}
+}
+
+func TestProjectJSON(t *testing.T) {
+	tests := []struct {
+		name   string
+		shape  string
+		actual string
+		want   string
+	}{
+		{name: "scalar taken whole", shape: `1`, actual: `2`, want: `2`},
+		{name: "array taken whole", shape: `[1]`, actual: `[1,2]`, want: `[1,2]`},
+		{name: "unmanaged keys dropped", shape: `{"a":0}`, actual: `{"a":1,"b":2}`, want: `{"a":1}`},
+		{name: "missing keys dropped", shape: `{"a":0,"c":0}`, actual: `{"a":1}`, want: `{"a":1}`},
+		{
+			name:   "nested keys projected",
+			shape:  `{"sftp":{"value":{"authenticationType":""}}}`,
+			actual: `{"sftp":{"enabled":true,"value":{"authenticationType":"publicKey","port":22}}}`,
+			want:   `{"sftp":{"value":{"authenticationType":"publicKey"}}}`,
+		},
+		{name: "object becomes scalar", shape: `{"a":{"b":1}}`, actual: `{"a":false}`, want: `{"a":false}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := projectJSON(decodeTestJSON(t, tt.shape), decodeTestJSON(t, tt.actual))
+			if want := decodeTestJSON(t, tt.want); !reflect.DeepEqual(got, want) {
+				t.Errorf("projectJSON() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestSetJSONPath(t *testing.T) {
+	tests := []struct {
+		name  string
+		root  string
+		keys  []string
+		value any
+		want  string
+	}{
+		{name: "top level", root: `{}`, keys: []string{"enabled"}, value: true, want: `{"enabled":true}`},
+		{name: "creates parents", root: `{}`, keys: []string{"ftp", "value", "enableZlib"}, value: false, want: `{"ftp":{"value":{"enableZlib":false}}}`},
+		{name: "keeps siblings", root: `{"ftp":{"enabled":true}}`, keys: []string{"ftp", "value", "enableZlib"}, value: true, want: `{"ftp":{"enabled":true,"value":{"enableZlib":true}}}`},
+		{name: "replaces scalar parent", root: `{"ftp":true}`, keys: []string{"ftp", "enabled"}, value: false, want: `{"ftp":{"enabled":false}}`},
+		{
+			name:  "merges object value",
+			root:  `{"connectionTimeout":{"enabled":true,"value":{"maxSec":600}}}`,
+			keys:  []string{"connectionTimeout"},
+			value: map[string]any{"enabled": false},
+			want:  `{"connectionTimeout":{"enabled":false,"value":{"maxSec":600}}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := decodeTestJSON(t, tt.root).(map[string]any)
+			setJSONPath(root, tt.keys, tt.value)
+			if want := decodeTestJSON(t, tt.want); !reflect.DeepEqual(any(root), want) {
+				t.Errorf("setJSONPath() = %v, want %v", root, want)
+			}
+		})
+	}
+}
+
+// testUserTemplateModel returns a model with every block unset.
+func testUserTemplateModel() siteUserTemplateResourceModel {
+	m := siteUserTemplateResourceModel{
+		Name:         types.StringValue("Contractors"),
+		Enabled:      types.BoolNull(),
+		SettingsJSON: types.StringNull(),
+	}
+	blocks := m.blocks()
+	for _, b := range userTemplateBlocks {
+		*blocks[b.name] = types.ObjectNull(b.attrTypes())
+	}
+	return m
+}
+
+func testUserTemplateBlock(t *testing.T, name string, set map[string]attr.Value) types.Object {
+	t.Helper()
+	for _, b := range userTemplateBlocks {
+		if b.name != name {
+			continue
+		}
+		values := make(map[string]attr.Value, len(b.fields))
+		for attrName, attrType := range b.attrTypes() {
+			values[attrName] = nullValueOf(attrType)
+		}
+		for attrName, value := range set {
+			values[attrName] = value
+		}
+		object, diags := types.ObjectValue(b.attrTypes(), values)
+		if diags.HasError() {
+			t.Fatalf("block %s: %v", name, diags)
+		}
+		return object
+	}
+	t.Fatalf("unknown block %s", name)
+	return types.Object{}
+}
+
+func nullValueOf(t attr.Type) attr.Value {
+	switch t {
+	case types.BoolType:
+		return types.BoolNull()
+	case types.Int64Type:
+		return types.Int64Null()
+	default:
+		return types.StringNull()
+	}
+}
+
+func TestSiteUserTemplateSettingsOverlaps(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings string
+		setup    func(t *testing.T, m *siteUserTemplateResourceModel)
+		want     []userTemplateOverlap
+	}{
+		{
+			name:     "no blocks",
+			settings: `{"sftp":{"enabled":true},"ftp":{"enabled":true}}`,
+		},
+		{
+			name:     "name always conflicts",
+			settings: `{"name":"Other"}`,
+			want:     []userTemplateOverlap{{attribute: "name", path: []string{"name"}}},
+		},
+		{
+			name:     "enabled conflicts when set",
+			settings: `{"enabled":false}`,
+			setup: func(_ *testing.T, m *siteUserTemplateResourceModel) {
+				m.Enabled = types.BoolValue(true)
+			},
+			want: []userTemplateOverlap{{attribute: "enabled", path: []string{"enabled"}}},
+		},
+		{
+			name:     "enabled unset",
+			settings: `{"enabled":false}`,
+		},
+		{
+			name:     "disjoint keys of the same setting",
+			settings: `{"ftp":{"value":{"enableComb":true}}}`,
+			setup: func(t *testing.T, m *siteUserTemplateResourceModel) {
+				m.FTP = testUserTemplateBlock(t, "ftp", map[string]attr.Value{"enabled": types.BoolValue(true)})
+			},
+		},
+		{
+			name:     "same key",
+			settings: `{"ftp":{"enabled":false}}`,
+			setup: func(t *testing.T, m *siteUserTemplateResourceModel) {
+				m.FTP = testUserTemplateBlock(t, "ftp", map[string]attr.Value{"enabled": types.BoolValue(true)})
+			},
+			want: []userTemplateOverlap{{attribute: "ftp.enabled", path: []string{"ftp", "enabled"}}},
+		},
+		{
+			name:     "scalar on the path",
+			settings: `{"ftp":{"value":false}}`,
+			setup: func(t *testing.T, m *siteUserTemplateResourceModel) {
+				m.FTP = testUserTemplateBlock(t, "ftp", map[string]attr.Value{"enable_zlib": types.BoolValue(true)})
+			},
+			want: []userTemplateOverlap{{attribute: "ftp.enable_zlib", path: []string{"ftp", "value"}}},
+		},
+		{
+			name:     "unset block attribute",
+			settings: `{"ftp":{"enabled":false}}`,
+			setup: func(t *testing.T, m *siteUserTemplateResourceModel) {
+				m.FTP = testUserTemplateBlock(t, "ftp", map[string]attr.Value{"enable_zlib": types.BoolValue(true)})
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := testUserTemplateModel()
+			if tt.setup != nil {
+				tt.setup(t, &m)
+			}
+			settings, err := parseUserTemplateSettings(tt.settings)
+			if err != nil {
+				t.Fatalf("parse settings: %v", err)
+			}
+			if got := m.settingsOverlaps(settings); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("settingsOverlaps() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSiteUserTemplateFromAPI(t *testing.T) {
+	m := testUserTemplateModel()
+	m.SiteID = types.StringValue("site-1")
+	m.SettingsJSON = types.StringValue(`{"sftp":{"enabled":true}}`)
+	m.FTP = testUserTemplateBlock(t, "ftp", map[string]attr.Value{
+		"enabled":    types.BoolValue(true),
+		"enable_fxp": types.StringValue("yes"),
+	})
+
+	template := &client.UserTemplate{
+		ID:         "tpl-1",
+		Attributes: json.RawMessage(`{"name":"Contractors","enabled":true,"sftp":{"enabled":false,"value":{"port":22}},"ftp":{"enabled":false,"value":{"enableFxp":"inherit","enableZlib":true}}}`),
+	}
+	if diags := m.fromAPI(template); diags.HasError() {
+		t.Fatalf("fromAPI() diagnostics: %v", diags)
+	}
+
+	if got, want := m.ID.ValueString(), "site-1/tpl-1"; got != want {
+		t.Errorf("id = %s, want %s", got, want)
+	}
+	if !m.Enabled.IsNull() {
+		t.Errorf("enabled = %s, want null when unmanaged", m.Enabled)
+	}
+	if got, want := m.SettingsJSON.ValueString(), `{"sftp":{"enabled":false}}`; got != want {
+		t.Errorf("settings_json = %s, want %s", got, want)
+	}
+
+	ftp := m.FTP.Attributes()
+	if got := ftp["enabled"]; !got.Equal(types.BoolValue(false)) {
+		t.Errorf("ftp.enabled = %s, want false", got)
+	}
+	if got := ftp["enable_fxp"]; !got.Equal(types.StringValue("inherit")) {
+		t.Errorf("ftp.enable_fxp = %s, want inherit", got)
+	}
+	if got := ftp["enable_zlib"]; !got.IsNull() {
+		t.Errorf("ftp.enable_zlib = %s, want null when unmanaged", got)
+	}
+	if !m.HTTP.IsNull() {
+		t.Errorf("http = %s, want null when unmanaged", m.HTTP)
+	}
+}
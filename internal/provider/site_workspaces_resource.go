@@ -0,0 +1,320 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &siteWorkspacesResource{}
+var _ resource.ResourceWithConfigure = &siteWorkspacesResource{}
+var _ resource.ResourceWithImportState = &siteWorkspacesResource{}
+
+func NewSiteWorkspacesResource() resource.Resource {
+	return &siteWorkspacesResource{}
+}
+
+type siteWorkspacesResource struct {
+	client *client.Client
+}
+
+type siteWorkspacesResourceModel struct {
+	ID                     types.String `tfsdk:"id"`
+	SiteID                 types.String `tfsdk:"site_id"`
+	Enabled                types.Bool   `tfsdk:"enabled"`
+	InviteNewUsers         types.Bool   `tfsdk:"invite_new_users"`
+	AllowedDomainsEnabled  types.Bool   `tfsdk:"allowed_domains_enabled"`
+	AllowedDomains         types.List   `tfsdk:"allowed_domains"`
+	DeniedDomainsEnabled   types.Bool   `tfsdk:"denied_domains_enabled"`
+	DeniedDomains          types.List   `tfsdk:"denied_domains"`
+	MaxExpirationValue     types.Int64  `tfsdk:"max_expiration_value"`
+	MaxExpirationUnits     types.String `tfsdk:"max_expiration_units"`
+	SecondVerification     types.String `tfsdk:"second_verification"`
+	GuestExpirationEnabled types.Bool   `tfsdk:"guest_expiration_enabled"`
+	GuestExpirationAction  types.String `tfsdk:"guest_expiration_action"`
+	GuestExpirationDays    types.Int64  `tfsdk:"guest_expiration_days"`
+	GuestGrantHomeFolder   types.Bool   `tfsdk:"guest_grant_home_folder"`
+}
+
+func (r *siteWorkspacesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_site_workspaces"
+}
+
+func (r *siteWorkspacesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the Workspaces (folder sharing) settings of a Globalscape EFT site. Only the configured attributes are sent to the API. Destroying this resource will only remove it from Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Site identifier.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site_id": schema.StringAttribute{
+				MarkdownDescription: "Site identifier whose Workspaces settings are managed.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled":                  optionalComputedBool("Whether users can share folders as Workspaces."),
+			"invite_new_users":         optionalComputedBool("Whether participants without an EFT account can be invited."),
+			"allowed_domains_enabled":  optionalComputedBool("Restrict invitations to `allowed_domains`."),
+			"allowed_domains":          domainListAttribute("Email domain patterns participants may be invited from, e.g. `*.example.com`."),
+			"denied_domains_enabled":   optionalComputedBool("Reject invitations to `denied_domains`."),
+			"denied_domains":           domainListAttribute("Email domain patterns participants may not be invited from."),
+			"max_expiration_value":     optionalComputedInt64("Maximum lifetime of a Workspace, in `max_expiration_units`.", int64validator.AtLeast(1)),
+			"max_expiration_units":     optionalComputedString("Unit of `max_expiration_value`: `Day`, `Week`, `Month` or `Year`.", stringvalidator.OneOf("Day", "Week", "Month", "Year")),
+			"second_verification":      optionalComputedString("Second verification required from invited participants, e.g. `email`.", stringvalidator.LengthAtLeast(1)),
+			"guest_expiration_enabled": optionalComputedBool("Whether guest accounts expire after their links expire."),
+			"guest_expiration_action":  optionalComputedString("What happens to expired guest accounts, e.g. `Disable` or `DeleteAccountOnly`.", stringvalidator.LengthAtLeast(1)),
+			"guest_expiration_days":    optionalComputedInt64("Days after link expiration before `guest_expiration_action` is applied.", int64validator.AtLeast(0)),
+			"guest_grant_home_folder":  optionalComputedBool("Whether guest accounts receive a home folder."),
+		},
+	}
+}
+
+func domainListAttribute(description string) schema.ListAttribute {
+	return schema.ListAttribute{
+		MarkdownDescription: description,
+		ElementType:         types.StringType,
+		Optional:            true,
+		Computed:            true,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+		},
+		PlanModifiers: []planmodifier.List{
+			listplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func (r *siteWorkspacesResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if c, ok := req.ProviderData.(*client.Client); ok {
+		r.client = c
+	}
+}
+
+func (r *siteWorkspacesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var plan siteWorkspacesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	update, diags := plan.toAPIModel(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.UpdateSiteWorkspaces(ctx, plan.SiteID.ValueString(), update)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update site Workspaces settings", err.Error())
+		return
+	}
+
+	newState, diags := fromSiteWorkspaces(ctx, settings)
+	resp.Diagnostics.Append(diags...)
+	newState.ID = plan.SiteID
+	newState.SiteID = plan.SiteID
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *siteWorkspacesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var state siteWorkspacesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	siteID := state.SiteID
+	if siteID.IsNull() {
+		siteID = state.ID
+	}
+
+	settings, err := r.client.GetSiteWorkspaces(ctx, siteID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read site Workspaces settings", err.Error())
+		return
+	}
+
+	newState, diags := fromSiteWorkspaces(ctx, settings)
+	resp.Diagnostics.Append(diags...)
+	newState.ID = siteID
+	newState.SiteID = siteID
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *siteWorkspacesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
+		return
+	}
+
+	var plan siteWorkspacesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	update, diags := plan.toAPIModel(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.UpdateSiteWorkspaces(ctx, plan.SiteID.ValueString(), update)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update site Workspaces settings", err.Error())
+		return
+	}
+
+	newState, diags := fromSiteWorkspaces(ctx, settings)
+	resp.Diagnostics.Append(diags...)
+	newState.ID = plan.ID
+	newState.SiteID = plan.SiteID
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *siteWorkspacesResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Workspaces settings are part of the site and cannot be deleted via the API.
+	// Removing the resource from Terraform state only; the site keeps its configuration.
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *siteWorkspacesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site_id"), req.ID)...)
+}
+
+// toAPIModel only populates the fields that are known in the plan so the PATCH
+// body stays partial.
+func (m *siteWorkspacesResourceModel) toAPIModel(ctx context.Context) (client.SiteWorkspaces, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	settings := client.SiteWorkspaces{
+		Enabled:            boolPointer(m.Enabled),
+		SecondVerification: stringPointer(m.SecondVerification),
+	}
+
+	newUsers := client.WorkspacesNewUsers{
+		Enabled:        boolPointer(m.InviteNewUsers),
+		AllowedDomains: domainFilterToAPI(ctx, m.AllowedDomainsEnabled, m.AllowedDomains, &diags),
+		DeniedDomains:  domainFilterToAPI(ctx, m.DeniedDomainsEnabled, m.DeniedDomains, &diags),
+	}
+	if newUsers.Enabled != nil || newUsers.AllowedDomains != nil || newUsers.DeniedDomains != nil {
+		settings.NewUsers = &newUsers
+	}
+
+	settings.MaxExpiration = expirationPeriodToAPI(m.MaxExpirationValue, m.MaxExpirationUnits)
+
+	expiration := client.WorkspacesGuestExpiration{
+		Enabled:                  boolPointer(m.GuestExpirationEnabled),
+		Action:                   stringPointer(m.GuestExpirationAction),
+		DaysAfterLinksExpiration: int64Pointer(m.GuestExpirationDays),
+	}
+	guests := client.WorkspacesGuests{GrantHomeFolder: boolPointer(m.GuestGrantHomeFolder)}
+	if expiration.Enabled != nil || expiration.Action != nil || expiration.DaysAfterLinksExpiration != nil {
+		guests.Expiration = &expiration
+	}
+	if guests.Expiration != nil || guests.GrantHomeFolder != nil {
+		settings.GuestAccounts = &guests
+	}
+
+	return settings, diags
+}
+
+func fromSiteWorkspaces(ctx context.Context, settings *client.SiteWorkspaces) (*siteWorkspacesResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	m := &siteWorkspacesResourceModel{
+		Enabled:            boolFromPointer(settings.Enabled),
+		SecondVerification: stringFromPointer(settings.SecondVerification),
+	}
+
+	newUsers := settings.NewUsers
+	if newUsers == nil {
+		newUsers = &client.WorkspacesNewUsers{}
+	}
+	m.InviteNewUsers = boolFromPointer(newUsers.Enabled)
+	m.AllowedDomainsEnabled, m.AllowedDomains = domainFilterFromAPI(ctx, newUsers.AllowedDomains, &diags)
+	m.DeniedDomainsEnabled, m.DeniedDomains = domainFilterFromAPI(ctx, newUsers.DeniedDomains, &diags)
+
+	maxExpiration := settings.MaxExpiration
+	if maxExpiration == nil {
+		maxExpiration = &client.ExpirationPeriod{}
+	}
+	m.MaxExpirationValue = int64FromPointer(maxExpiration.Value)
+	m.MaxExpirationUnits = stringFromPointer(maxExpiration.Units)
+
+	guests := settings.GuestAccounts
+	if guests == nil {
+		guests = &client.WorkspacesGuests{}
+	}
+	expiration := guests.Expiration
+	if expiration == nil {
+		expiration = &client.WorkspacesGuestExpiration{}
+	}
+	m.GuestExpirationEnabled = boolFromPointer(expiration.Enabled)
+	m.GuestExpirationAction = stringFromPointer(expiration.Action)
+	m.GuestExpirationDays = int64FromPointer(expiration.DaysAfterLinksExpiration)
+	m.GuestGrantHomeFolder = boolFromPointer(guests.GrantHomeFolder)
+
+	return m, diags
+}
+
+func expirationPeriodToAPI(value types.Int64, units types.String) *client.ExpirationPeriod {
+	period := client.ExpirationPeriod{Value: int64Pointer(value), Units: stringPointer(units)}
+	if period.Value == nil && period.Units == nil {
+		return nil
+	}
+	return &period
+}
+
+// domainFilterToAPI returns nil when neither the flag nor the list is known so
+// the section is left out of a partial PATCH.
+func domainFilterToAPI(ctx context.Context, enabled types.Bool, list types.List, diags *diag.Diagnostics) *client.DomainFilter {
+	filter := client.DomainFilter{Enabled: boolPointer(enabled)}
+	if !list.IsNull() && !list.IsUnknown() {
+		diags.Append(list.ElementsAs(ctx, &filter.List, false)...)
+	}
+	if filter.Enabled == nil && filter.List == nil {
+		return nil
+	}
+	return &filter
+}
+
+func domainFilterFromAPI(ctx context.Context, d *client.DomainFilter, diags *diag.Diagnostics) (types.Bool, types.List) {
+	if d == nil {
+		return types.BoolNull(), types.ListNull(types.StringType)
+	}
+	list, listDiags := types.ListValueFrom(ctx, types.StringType, d.List)
+	diags.Append(listDiags...)
+	return boolFromPointer(d.Enabled), list
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDomainFilterToAPI(t *testing.T) {
+	ctx := context.Background()
+	domains := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("*.example.com")})
+
+	tests := []struct {
+		name    string
+		enabled types.Bool
+		list    types.List
+		want    string
+	}{
+		{name: "nothing known", enabled: types.BoolUnknown(), list: types.ListUnknown(types.StringType), want: "null"},
+		{name: "nothing set", enabled: types.BoolNull(), list: types.ListNull(types.StringType), want: "null"},
+		{name: "flag only", enabled: types.BoolValue(false), list: types.ListUnknown(types.StringType), want: `{"enabled":false}`},
+		{name: "list only", enabled: types.BoolUnknown(), list: domains, want: `{"list":["*.example.com"]}`},
+		{name: "flag and list", enabled: types.BoolValue(true), list: domains, want: `{"enabled":true,"list":["*.example.com"]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			got := domainFilterToAPI(ctx, tt.enabled, tt.list, &diags)
+			if diags.HasError() {
+				t.Fatalf("unexpected errors: %v", diags)
+			}
+			body, _ := json.Marshal(got)
+			if string(body) != tt.want {
+				t.Errorf("domainFilterToAPI() = %s, want %s", body, tt.want)
+			}
+		})
+	}
+}
+
+func TestDomainFilterFromAPI(t *testing.T) {
+	ctx := context.Background()
+	enabled := true
+
+	tests := []struct {
+		name        string
+		filter      *client.DomainFilter
+		wantEnabled types.Bool
+		wantLen     int
+		wantNull    bool
+	}{
+		{name: "missing section", wantEnabled: types.BoolNull(), wantNull: true},
+		{name: "empty list", filter: &client.DomainFilter{Enabled: &enabled}, wantEnabled: types.BoolValue(true), wantNull: true},
+		{name: "domains", filter: &client.DomainFilter{List: []string{"a.com", "b.com"}}, wantEnabled: types.BoolNull(), wantLen: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			gotEnabled, gotList := domainFilterFromAPI(ctx, tt.filter, &diags)
+			if diags.HasError() {
+				t.Fatalf("unexpected errors: %v", diags)
+			}
+			if !gotEnabled.Equal(tt.wantEnabled) {
+				t.Errorf("enabled = %s, want %s", gotEnabled, tt.wantEnabled)
+			}
+			if gotList.IsNull() != tt.wantNull || len(gotList.Elements()) != tt.wantLen {
+				t.Errorf("list = %s, want null=%t len=%d", gotList, tt.wantNull, tt.wantLen)
+			}
+		})
+	}
+}
+
+func TestSiteWorkspacesToAPIModel(t *testing.T) {
+	ctx := context.Background()
+
+	m, diags := fromSiteWorkspaces(ctx, &client.SiteWorkspaces{})
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+	m.Enabled = types.BoolValue(true)
+	m.DeniedDomainsEnabled = types.BoolValue(true)
+	m.DeniedDomains = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("*.example.org")})
+	m.MaxExpirationValue = types.Int64Value(1)
+	m.MaxExpirationUnits = types.StringValue("Year")
+	m.GuestGrantHomeFolder = types.BoolValue(false)
+
+	settings, diags := m.toAPIModel(ctx)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+	body, _ := json.Marshal(settings)
+	want := `{"enabled":true,"newUsers":{"deniedDomains":{"enabled":true,"list":["*.example.org"]}},"maxExpiration":{"value":1,"units":"Year"},"guestAccounts":{"grantHomeFolder":false}}`
+	if string(body) != want {
+		t.Errorf("toAPIModel() =\n%s\nwant\n%s", body, want)
+	}
+}
+
+func TestFromSiteWorkspaces(t *testing.T) {
+	var settings client.SiteWorkspaces
+	body := `{"enabled":true,"newUsers":{"enabled":true,"allowedDomains":{"enabled":true,"list":["*.example.com"]}},"maxExpiration":{"value":30,"units":"Day"},"guestAccounts":{"expiration":{"enabled":true,"action":"Disable","daysAfterLinksExpiration":7}}}`
+	if err := json.Unmarshal([]byte(body), &settings); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	m, diags := fromSiteWorkspaces(context.Background(), &settings)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+	if !m.InviteNewUsers.ValueBool() || !m.AllowedDomainsEnabled.ValueBool() || len(m.AllowedDomains.Elements()) != 1 {
+		t.Errorf("new users not read back: %+v", m)
+	}
+	if !m.DeniedDomainsEnabled.IsNull() || !m.DeniedDomains.IsNull() {
+		t.Errorf("denied domains = %s/%s, want null", m.DeniedDomainsEnabled, m.DeniedDomains)
+	}
+	if m.MaxExpirationValue.ValueInt64() != 30 || m.MaxExpirationUnits.ValueString() != "Day" {
+		t.Errorf("max expiration = %s %s", m.MaxExpirationValue, m.MaxExpirationUnits)
+	}
+	if m.GuestExpirationAction.ValueString() != "Disable" || m.GuestExpirationDays.ValueInt64() != 7 || !m.GuestGrantHomeFolder.IsNull() {
+		t.Errorf("guest accounts not read back: %+v", m)
+	}
+}
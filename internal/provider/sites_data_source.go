@@ -6,6 +6,7 @@ import (
 	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -20,12 +21,15 @@ type sitesDataSource struct {
 }
 
 type sitesDataSourceModel struct {
-	Sites []siteModel `tfsdk:"sites"`
+	Name  types.String `tfsdk:"name"`
+	Sites []siteModel  `tfsdk:"sites"`
 }
 
 type siteModel struct {
-	ID   types.String `tfsdk:"id"`
-	Name types.String `tfsdk:"name"`
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	State        types.String `tfsdk:"state"`
+	DMZConnected types.Bool   `tfsdk:"dmz_connected"`
 }
 
 func (d *sitesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -36,6 +40,10 @@ func (d *sitesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "List Globalscape EFT sites configured on the server.",
 		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Only return the site with this exact name.",
+				Optional:            true,
+			},
 			"sites": schema.ListNestedAttribute{
 				MarkdownDescription: "Sites configured on the server.",
 				Computed:            true,
@@ -49,6 +57,14 @@ func (d *sitesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 							Computed:            true,
 							MarkdownDescription: "Site name/label.",
 						},
+						"state": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Site state (for example `Running` or `Stopped`). On a cluster, `Running` means the site runs on every node; otherwise the first other state reported is returned. Null when the status endpoint is unavailable.",
+						},
+						"dmz_connected": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "True when any node reports the site connected to DMZ Gateway. Null when the status endpoint is unavailable.",
+						},
 					},
 				},
 			},
@@ -66,13 +82,17 @@ func (d *sitesDataSource) Configure(_ context.Context, req datasource.ConfigureR
 	}
 }
 
-func (d *sitesDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+func (d *sitesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	if d.client == nil {
 		resp.Diagnostics.AddError("Unconfigured client", "the provider client was not initialized")
 		return
 	}
 
 	var state sitesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	sites, err := d.client.ListSites(ctx)
 	if err != nil {
@@ -80,12 +100,71 @@ func (d *sitesDataSource) Read(ctx context.Context, _ datasource.ReadRequest, re
 		return
 	}
 
+	statuses, diags := lookupSiteStatuses(ctx, d.client)
+	resp.Diagnostics.Append(diags...)
+
+	state.Sites = []siteModel{}
 	for _, s := range sites {
+		if name := stringValueOrEmpty(state.Name); name != "" && s.Attributes.Name != name {
+			continue
+		}
+
+		siteState, dmzConnected := summarizeSiteStatus(statuses[s.ID])
 		state.Sites = append(state.Sites, siteModel{
-			ID:   types.StringValue(s.ID),
-			Name: types.StringValue(s.Attributes.Name),
+			ID:           types.StringValue(s.ID),
+			Name:         types.StringValue(s.Attributes.Name),
+			State:        siteState,
+			DMZConnected: dmzConnected,
 		})
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
+
+// lookupSiteStatuses returns the per-node status of every site. The status
+// endpoint is informational, so a failure only produces a warning and a nil
+// map instead of failing the data source.
+func lookupSiteStatuses(ctx context.Context, c *client.Client) (map[string][]siteStatusEntry, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	status, err := c.GetSitesStatus(ctx)
+	if err != nil {
+		diags.AddWarning("Unable to query site status", "Site state is left empty. "+err.Error())
+		return nil, diags
+	}
+	return siteStatusesByID(status), diags
+}
+
+// siteStatusEntry is the status of one site on one node.
+type siteStatusEntry struct {
+	NodeName string
+	Status   client.SiteStatus
+}
+
+func siteStatusesByID(status *client.SitesStatus) map[string][]siteStatusEntry {
+	out := map[string][]siteStatusEntry{}
+	for _, node := range status.Info {
+		for _, s := range node.Sites {
+			out[s.ID] = append(out[s.ID], siteStatusEntry{NodeName: node.NodeName, Status: s})
+		}
+	}
+	return out
+}
+
+// summarizeSiteStatus collapses per-node status into a single state. Any node
+// not reporting Running wins so that a partially stopped site is visible.
+func summarizeSiteStatus(entries []siteStatusEntry) (types.String, types.Bool) {
+	if len(entries) == 0 {
+		return types.StringNull(), types.BoolNull()
+	}
+
+	state := entries[0].Status.State
+	dmzConnected := false
+	for _, e := range entries {
+		if state == "Running" && e.Status.State != "Running" {
+			state = e.Status.State
+		}
+		dmzConnected = dmzConnected || e.Status.DMZConnected
+	}
+	return types.StringValue(state), types.BoolValue(dmzConnected)
+}
@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+)
+
+func TestSummarizeSiteStatus(t *testing.T) {
+	tests := []struct {
+		name        string
+		entries     []siteStatusEntry
+		wantNull    bool
+		wantState   string
+		wantDMZConn bool
+	}{
+		{name: "no status", wantNull: true},
+		{
+			name:      "single node running",
+			entries:   []siteStatusEntry{{NodeName: "eft1", Status: client.SiteStatus{State: "Running"}}},
+			wantState: "Running",
+		},
+		{
+			name: "stopped on one node wins",
+			entries: []siteStatusEntry{
+				{NodeName: "eft1", Status: client.SiteStatus{State: "Running"}},
+				{NodeName: "eft2", Status: client.SiteStatus{State: "Stopped"}},
+			},
+			wantState: "Stopped",
+		},
+		{
+			name: "first non-running state kept",
+			entries: []siteStatusEntry{
+				{NodeName: "eft1", Status: client.SiteStatus{State: "Stopped"}},
+				{NodeName: "eft2", Status: client.SiteStatus{State: "Paused"}},
+			},
+			wantState: "Stopped",
+		},
+		{
+			name: "dmz connected on any node",
+			entries: []siteStatusEntry{
+				{NodeName: "eft1", Status: client.SiteStatus{State: "Running"}},
+				{NodeName: "eft2", Status: client.SiteStatus{State: "Running", DMZConnected: true}},
+			},
+			wantState:   "Running",
+			wantDMZConn: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state, dmz := summarizeSiteStatus(tt.entries)
+			if tt.wantNull {
+				if !state.IsNull() || !dmz.IsNull() {
+					t.Fatalf("expected null values, got %s and %s", state, dmz)
+				}
+				return
+			}
+			if state.ValueString() != tt.wantState || dmz.ValueBool() != tt.wantDMZConn {
+				t.Fatalf("got %q/%t, want %q/%t", state.ValueString(), dmz.ValueBool(), tt.wantState, tt.wantDMZConn)
+			}
+		})
+	}
+}
+
+func TestSiteStatusesByID(t *testing.T) {
+	status := &client.SitesStatus{Info: []client.SitesStatusNode{
+		{NodeName: "eft1", Sites: []client.SiteStatus{{ID: "a", State: "Running"}, {ID: "b", State: "Stopped"}}},
+		{NodeName: "eft2", Sites: []client.SiteStatus{{ID: "a", State: "Running"}}},
+	}}
+
+	got := siteStatusesByID(status)
+	if len(got["a"]) != 2 || got["a"][1].NodeName != "eft2" {
+		t.Fatalf("unexpected entries for site a: %+v", got["a"])
+	}
+	if len(got["b"]) != 1 || got["b"][0].Status.State != "Stopped" {
+		t.Fatalf("unexpected entries for site b: %+v", got["b"])
+	}
+}
+
+func TestLookupSiteStatuses(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		body        string
+		wantWarning bool
+		wantSites   int
+	}{
+		{
+			name:      "status available",
+			status:    http.StatusOK,
+			body:      `{"info":[{"nodeName":"eft1","sites":[{"id":"a","state":"Running"}]}]}`,
+			wantSites: 1,
+		},
+		{
+			name:        "status endpoint fails",
+			status:      http.StatusInternalServerError,
+			body:        `{"message":"boom"}`,
+			wantWarning: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /admin/v1/sites/status", func(w http.ResponseWriter, _ *http.Request) {
+				writeTestJSON(w, tt.status, tt.body)
+			})
+			c := newTestClient(t, mux)
+
+			statuses, diags := lookupSiteStatuses(context.Background(), c)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if got := diags.WarningsCount() > 0; got != tt.wantWarning {
+				t.Fatalf("warning = %t, want %t", got, tt.wantWarning)
+			}
+			if tt.wantWarning && statuses != nil {
+				t.Fatalf("expected nil statuses on failure, got %+v", statuses)
+			}
+			if len(statuses) != tt.wantSites {
+				t.Fatalf("got %d sites, want %d", len(statuses), tt.wantSites)
+			}
+		})
+	}
+}
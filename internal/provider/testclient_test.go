@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/InfoSecured/globalscape-eft-terraform-provider/internal/client"
+)
+
+// newTestClient returns a client for a fake EFT server. mux serves the API
+// routes; authentication is answered by the helper.
+func newTestClient(t *testing.T, mux *http.ServeMux) *client.Client {
+	t.Helper()
+
+	mux.HandleFunc("POST /admin/v1/authentication", func(w http.ResponseWriter, _ *http.Request) {
+		writeTestJSON(w, http.StatusOK, `{"authToken":"test-token"}`)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	c, err := client.NewClient(context.Background(), client.Config{BaseURL: server.URL, Username: "admin", Password: "secret", AuthType: "EFT"})
+	if err != nil {
+		t.Fatalf("creating test client: %v", err)
+	}
+	return c
+}
+
+func writeTestJSON(w http.ResponseWriter, status int, body string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write([]byte(body))
+}